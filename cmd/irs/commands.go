@@ -0,0 +1,308 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/file"
+	"github.com/moov-io/irs/pkg/sql"
+)
+
+// exit codes of commands
+const (
+	exitOK      = 0
+	exitFailure = 1
+	exitUsage   = 2
+)
+
+const usage = `Usage:
+  irs                                 start the HTTP server
+  irs validate [--tax-year <year>] <file>
+                                      validate a FIRE ascii or JSON file
+  irs convert --to json|ascii|csv [--mapping <mapping>] <file>
+                                      convert a file to JSON, FIRE ascii or CSV rows of payees
+  irs import [--to json|ascii] [--mapping <mapping>] <file> <csv file>
+                                      add payees of CSV rows to a file and write the file
+  irs print <file>                    print fields of all records of a file
+  irs sql --dialect sqlite|mysql [--id <file id>] <file>
+                                      write SQL statements creating tables and inserting all records of a file
+`
+
+// command runs with arguments, writing results to stdout and problems to stderr, and returns the exit code
+type command func(args []string, stdout, stderr io.Writer) int
+
+var commands = map[string]command{
+	"validate": validateCommand,
+	"convert":  convertCommand,
+	"import":   importCommand,
+	"print":    printCommand,
+	"sql":      sqlCommand,
+}
+
+// runCommand runs the command named by the first argument
+func runCommand(args []string, stdout, stderr io.Writer) int {
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintf(stderr, "unknown command %q\n%s", args[0], usage)
+		return exitUsage
+	}
+	return cmd(args[1:], stdout, stderr)
+}
+
+func validateCommand(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("validate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	taxYear := flags.Int("tax-year", 0, "tax year of the filing season, checked with payment year of prior year data")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+
+	f, code := readFile("validate", flags.Args(), stderr)
+	if f == nil {
+		return code
+	}
+
+	f.SetValidation(&file.ValidateOpts{TaxYear: *taxYear})
+
+	if err := f.Validate(); err != nil {
+		fmt.Fprintln(stderr, strings.ReplaceAll(err.Error(), "; ", "\n"))
+		return exitFailure
+	}
+	fmt.Fprintln(stdout, "file is valid")
+	return exitOK
+}
+
+func convertCommand(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("convert", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	format := flags.String("to", file.FormatJson, "format of the converted file, json, ascii or csv")
+	mappingFile := flags.String("mapping", "", "JSON file mapping CSV columns to fields of records")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *format != file.FormatJson && *format != file.FormatAscii && *format != file.FormatCsv {
+		fmt.Fprintf(stderr, "unknown format %q\n%s", *format, usage)
+		return exitUsage
+	}
+
+	mapping, err := readMapping(*mappingFile)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+	f, code := readFile("convert", flags.Args(), stderr)
+	if f == nil {
+		return code
+	}
+
+	if err := writeFile(f, *format, mapping, stdout); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+	return exitOK
+}
+
+func importCommand(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	format := flags.String("to", file.FormatJson, "format of the written file, json or ascii")
+	mappingFile := flags.String("mapping", "", "JSON file mapping CSV columns to fields of records")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if *format != file.FormatJson && *format != file.FormatAscii {
+		fmt.Fprintf(stderr, "unknown format %q\n%s", *format, usage)
+		return exitUsage
+	}
+	if flags.NArg() != 2 {
+		fmt.Fprintf(stderr, "import requires a file and a csv file\n%s", usage)
+		return exitUsage
+	}
+
+	mapping, err := readMapping(*mappingFile)
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+	f, code := readFile("import", flags.Args()[:1], stderr)
+	if f == nil {
+		return code
+	}
+	csvFile, err := os.Open(flags.Arg(1))
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+	defer csvFile.Close()
+
+	if err := file.ImportCsv(f, csvFile, mapping); err != nil {
+		fmt.Fprintln(stderr, strings.ReplaceAll(err.Error(), "; ", "\n"))
+		return exitFailure
+	}
+	if err := writeFile(f, *format, nil, stdout); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+	return exitOK
+}
+
+func printCommand(args []string, stdout, stderr io.Writer) int {
+	f, code := readFile("print", args, stderr)
+	if f == nil {
+		return code
+	}
+
+	if err := printRecords(f.Ascii(), stdout); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+	return exitOK
+}
+
+func sqlCommand(args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("sql", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	dialect := flags.String("dialect", string(sql.SQLite), "SQL dialect of statements, sqlite or mysql")
+	fileID := flags.String("id", "", "identifier of the file in all tables, the file name by default")
+	if err := flags.Parse(args); err != nil {
+		return exitUsage
+	}
+	if d := sql.Dialect(*dialect); d != sql.SQLite && d != sql.MySQL {
+		fmt.Fprintf(stderr, "unknown dialect %q\n%s", *dialect, usage)
+		return exitUsage
+	}
+
+	f, code := readFile("sql", flags.Args(), stderr)
+	if f == nil {
+		return code
+	}
+	if len(*fileID) == 0 {
+		*fileID = filepath.Base(flags.Arg(0))
+	}
+
+	if err := sql.Export(stdout, sql.Dialect(*dialect), *fileID, f); err != nil {
+		fmt.Fprintln(stderr, err)
+		return exitFailure
+	}
+	return exitOK
+}
+
+// readFile reads the file named by the only argument, returning the exit code if it couldn't be read
+func readFile(name string, args []string, stderr io.Writer) (file.File, int) {
+	if len(args) != 1 {
+		fmt.Fprintf(stderr, "%s requires one file\n%s", name, usage)
+		return nil, exitUsage
+	}
+
+	buf, err := ioutil.ReadFile(args[0])
+	if err != nil {
+		fmt.Fprintln(stderr, err)
+		return nil, exitFailure
+	}
+
+	f, err := file.CreateFile(buf)
+	if err != nil {
+		fmt.Fprintf(stderr, "unable to parse %s: %v\n", args[0], err)
+		return nil, exitFailure
+	}
+	return f, exitOK
+}
+
+// readMapping reads the CSV column mapping from the JSON file, an empty path returns no mapping
+func readMapping(path string) (file.CsvMapping, error) {
+	if len(path) == 0 {
+		return nil, nil
+	}
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var mapping file.CsvMapping
+	if err := json.Unmarshal(buf, &mapping); err != nil {
+		return nil, fmt.Errorf("unable to parse mapping %s: %v", path, err)
+	}
+	return mapping, nil
+}
+
+// writeFile writes the file in the format, CSV rows of payees are written with columns of the mapping
+func writeFile(f file.File, format string, mapping file.CsvMapping, w io.Writer) error {
+	switch format {
+	case file.FormatAscii:
+		_, err := w.Write(f.Ascii())
+		return err
+	case file.FormatCsv:
+		return file.ExportCsv(f, w, mapping)
+	}
+
+	buf, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(buf))
+	return err
+}
+
+// printRecords writes fields of every record of fire ascii, along with fields of extension blocks of payee “B” records
+func printRecords(buf []byte, w io.Writer) error {
+	if len(buf)%config.RecordLength != 0 {
+		return errors.New("unexpected length of fire ascii")
+	}
+
+	typeOfReturn := ""
+	for offset, number := 0, 1; offset < len(buf); offset, number = offset+config.RecordLength, number+1 {
+		record := string(buf[offset : offset+config.RecordLength])
+		recordType := record[:1]
+		layout, ok := config.RecordLayouts[recordType]
+		if !ok {
+			return fmt.Errorf("unknown record type %q of record #%d", recordType, number)
+		}
+
+		if recordType == config.ARecordType {
+			spec := layout["TypeOfReturn"]
+			typeOfReturn = config.TypeOfReturns[strings.TrimSpace(record[spec.Start:spec.Start+spec.Length])]
+		}
+
+		if recordType == config.BRecordType {
+			fmt.Fprintf(w, "%s record #%d (%s)\n", recordType, number, typeOfReturn)
+		} else {
+			fmt.Fprintf(w, "%s record #%d\n", recordType, number)
+		}
+		printFields(record, layout, 0, w)
+
+		if recordType == config.BRecordType {
+			if extLayout, ok := config.SubRecordLayouts[typeOfReturn]; ok {
+				offset := config.RecordLength - config.SubRecordLength
+				printFields(record[offset:], extLayout, offset, w)
+			}
+		}
+	}
+	return nil
+}
+
+// printFields writes name, columns and value of non-blank fields of the record
+func printFields(record string, layout map[string]config.SpecField, offset int, w io.Writer) {
+	for _, spec := range config.ToSpecifications(layout) {
+		field := spec.Field
+		if field.Required == config.Nullable || field.Required == config.Expandable {
+			continue
+		}
+		if len(record) < field.Start+field.Length {
+			continue
+		}
+		value := strings.TrimSpace(record[field.Start : field.Start+field.Length])
+		start := offset + field.Start + 1
+		fmt.Fprintf(w, "  %-32s %3d-%-3d  %s\n", spec.Name, start, start+field.Length-1, value)
+	}
+}
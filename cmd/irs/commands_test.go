@@ -0,0 +1,145 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/irs/pkg/file"
+	"github.com/stretchr/testify/require"
+)
+
+func testFile(name string) string {
+	return filepath.Join("..", "..", "test", "testdata", name)
+}
+
+func run(args ...string) (int, string, string) {
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	code := runCommand(args, stdout, stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func Test_ValidateCommand(t *testing.T) {
+	a := require.New(t)
+
+	code, stdout, _ := run("validate", testFile("oneTransactionFile.ascii"))
+	a.Equal(exitOK, code)
+	a.Contains(stdout, "file is valid")
+
+	code, _, stderr := run("validate", "--tax-year", "2017", testFile("oneTransactionFile.ascii"))
+	a.Equal(exitFailure, code)
+	a.Contains(stderr, "should be blank when reporting current year data")
+
+	code, _, stderr = run("validate", testFile("payeeRecordWith1099Misc.json"))
+	a.Equal(exitFailure, code)
+	a.Contains(stderr, "is required field")
+
+	code, _, _ = run("validate", testFile("unknown.ascii"))
+	a.Equal(exitFailure, code)
+
+	code, _, _ = run("validate")
+	a.Equal(exitUsage, code)
+}
+
+func Test_ConvertCommand(t *testing.T) {
+	a := require.New(t)
+
+	ascii, err := ioutil.ReadFile(testFile("oneTransactionFile.ascii"))
+	a.Nil(err)
+
+	code, stdout, _ := run("convert", "--to", "ascii", testFile("oneTransactionFile.json"))
+	a.Equal(exitOK, code)
+	a.Equal(string(ascii), stdout)
+
+	code, stdout, _ = run("convert", "--to", "json", testFile("oneTransactionFile.ascii"))
+	a.Equal(exitOK, code)
+	f, err := file.CreateFile([]byte(stdout))
+	a.Nil(err)
+	a.Equal(string(ascii), string(f.Ascii()))
+
+	code, stdout, _ = run("convert", "--to", "csv", testFile("oneTransactionFile.ascii"))
+	a.Equal(exitOK, code)
+	a.True(strings.HasPrefix(stdout, "payer_tin,type_of_return,payment_year,"))
+	a.Len(strings.Split(strings.TrimSpace(stdout), "\n"), 3)
+
+	code, _, _ = run("convert", "--to", "xml", testFile("oneTransactionFile.ascii"))
+	a.Equal(exitUsage, code)
+}
+
+func Test_ImportCommand(t *testing.T) {
+	a := require.New(t)
+
+	dir, err := ioutil.TempDir("", "irs")
+	a.Nil(err)
+	defer os.RemoveAll(dir)
+
+	mappingFile := filepath.Join(dir, "mapping.json")
+	a.Nil(ioutil.WriteFile(mappingFile, []byte(`[{"header": "Payee TIN", "field": "payees_tin"}, {"header": "Form", "field": "type_of_return"}]`), 0600))
+	code, stdout, _ := run("convert", "--to", "csv", testFile("oneTransactionFile.ascii"))
+	a.Equal(exitOK, code)
+	stdout = strings.Replace(stdout, "type_of_return,", "Form,", 1)
+	stdout = strings.Replace(stdout, "payees_tin,", "Payee TIN,", 1)
+	csvFile := filepath.Join(dir, "payees.csv")
+	a.Nil(ioutil.WriteFile(csvFile, []byte(stdout), 0600))
+
+	code, stdout, _ = run("import", "--to", "ascii", "--mapping", mappingFile, testFile("oneTransactionFile.ascii"), csvFile)
+	a.Equal(exitOK, code)
+	f, err := file.CreateFile([]byte(stdout))
+	a.Nil(err)
+	a.Nil(f.Validate())
+	buf := &bytes.Buffer{}
+	a.Nil(file.ExportCsv(f, buf, nil))
+	a.Len(strings.Split(strings.TrimSpace(buf.String()), "\n"), 5)
+
+	a.Nil(ioutil.WriteFile(csvFile, []byte("payer_tin,type_of_return,payees_tin\n123456789,A,12345\n"), 0600))
+	code, _, stderr := run("import", testFile("oneTransactionFile.ascii"), csvFile)
+	a.Equal(exitFailure, code)
+	a.Contains(stderr, "row 2")
+
+	code, _, _ = run("import", testFile("oneTransactionFile.ascii"))
+	a.Equal(exitUsage, code)
+}
+
+func Test_PrintCommand(t *testing.T) {
+	a := require.New(t)
+
+	code, stdout, _ := run("print", testFile("oneTransactionFile.ascii"))
+	a.Equal(exitOK, code)
+	a.True(strings.HasPrefix(stdout, "T record #1\n"))
+	a.Contains(stdout, "B record #3 (1099-MISC)")
+	a.Contains(stdout, "StateIncomeTaxWithheld")
+	a.Contains(stdout, "F record #")
+}
+
+func Test_SqlCommand(t *testing.T) {
+	a := require.New(t)
+
+	code, stdout, _ := run("sql", "--dialect", "mysql", testFile("oneTransactionFile.ascii"))
+	a.Equal(exitOK, code)
+	a.Contains(stdout, "CREATE TABLE IF NOT EXISTS `fire_payees`")
+	a.Contains(stdout, "VALUES ('oneTransactionFile.ascii', 'T'")
+
+	code, stdout, _ = run("sql", "--id", "2017", testFile("oneTransactionFile.ascii"))
+	a.Equal(exitOK, code)
+	a.Contains(stdout, `INSERT INTO "fire_transmitters" ("file_id"`)
+	a.Contains(stdout, "VALUES ('2017', 'T'")
+
+	code, _, stderr := run("sql", "--dialect", "postgres", testFile("oneTransactionFile.ascii"))
+	a.Equal(exitUsage, code)
+	a.Contains(stderr, "unknown dialect")
+}
+
+func Test_UnknownCommand(t *testing.T) {
+	a := require.New(t)
+
+	code, _, stderr := run("unknown")
+	a.Equal(exitUsage, code)
+	a.Contains(stderr, "Usage:")
+}
@@ -12,6 +12,10 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		os.Exit(runCommand(os.Args[1:], os.Stdout, os.Stderr))
+	}
+
 	env := &service.Environment{
 		Logger: logging.NewDefaultLogger().WithKeyValue("app", "irs"),
 	}
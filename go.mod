@@ -5,9 +5,11 @@ go 1.14
 require (
 	github.com/go-kit/kit v0.10.0
 	github.com/gorilla/mux v1.7.4
+	github.com/markbates/pkger v0.17.0
 	github.com/moov-io/base v0.11.0
 	github.com/moov-io/identity v0.2.3
 	github.com/moov-io/tumbler v0.1.3
 	github.com/stretchr/testify v1.6.1
+	golang.org/x/oauth2 v0.0.0-20200107190931-bf48bf16ab8d
 	gopkg.in/check.v1 v1.0.0-20190902080502-41f04d3bba15
 )
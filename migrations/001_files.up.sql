@@ -0,0 +1,10 @@
+CREATE TABLE files (
+    file_id         VARCHAR(40) NOT NULL,
+    tenant_id       VARCHAR(36) NOT NULL,
+    created_on      TIMESTAMP NOT NULL,
+    last_updated_on TIMESTAMP NOT NULL,
+
+    CONSTRAINT files_pk PRIMARY KEY (file_id)
+);
+
+CREATE INDEX files_tenant_id_idx ON files (tenant_id, created_on);
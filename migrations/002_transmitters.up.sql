@@ -0,0 +1,47 @@
+CREATE TABLE transmitters (
+    file_id                          VARCHAR(40) NOT NULL,
+    record_type                      VARCHAR(1) NOT NULL,
+    payment_year                     INTEGER NOT NULL,
+    prior_year_data_indicator        VARCHAR(1),
+    transmitter_tin                  VARCHAR(9) NOT NULL,
+    transmitter_control_code         VARCHAR(5) NOT NULL,
+    test_file_indicator              VARCHAR(1),
+    foreign_entity_indicator         VARCHAR(1),
+    transmitter_name                 VARCHAR(40) NOT NULL,
+    transmitter_name_contd           VARCHAR(40),
+    company_name                     VARCHAR(40) NOT NULL,
+    company_name_contd               VARCHAR(40),
+    company_mailing_address          VARCHAR(40) NOT NULL,
+    company_city                     VARCHAR(40) NOT NULL,
+    company_state                    VARCHAR(2) NOT NULL,
+    company_zip_code                 VARCHAR(9) NOT NULL,
+    total_number_of_payees           INTEGER,
+    contact_name                     VARCHAR(40) NOT NULL,
+    contact_telephone_number_and_ext VARCHAR(15) NOT NULL,
+    contact_email_address            VARCHAR(50),
+    record_sequence_number           INTEGER NOT NULL,
+    vendor_indicator                 VARCHAR(1) NOT NULL,
+    vendor_name                      VARCHAR(40) NOT NULL,
+    vendor_mailing_address           VARCHAR(40) NOT NULL,
+    vendor_city                      VARCHAR(40) NOT NULL,
+    vendor_state                     VARCHAR(2) NOT NULL,
+    vendor_zip_code                  VARCHAR(9) NOT NULL,
+    vendor_contact_name              VARCHAR(40) NOT NULL,
+    vendor_contact_telephone_and_ext VARCHAR(15) NOT NULL,
+    vendor_foreign_entity_indicator  VARCHAR(1),
+
+    CONSTRAINT transmitters_pk PRIMARY KEY (file_id),
+    CONSTRAINT transmitters_file_id_fk FOREIGN KEY (file_id) REFERENCES files (file_id)
+);
+
+CREATE TABLE end_transmitters (
+    file_id                 VARCHAR(40) NOT NULL,
+    record_type             VARCHAR(1) NOT NULL,
+    number_of_payer_records INTEGER NOT NULL,
+    zero                    BIGINT,
+    total_number_of_payees  INTEGER,
+    record_sequence_number  INTEGER NOT NULL,
+
+    CONSTRAINT end_transmitters_pk PRIMARY KEY (file_id),
+    CONSTRAINT end_transmitters_file_id_fk FOREIGN KEY (file_id) REFERENCES files (file_id)
+);
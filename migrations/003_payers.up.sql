@@ -0,0 +1,54 @@
+CREATE TABLE payers (
+    payer_id                       VARCHAR(40) NOT NULL,
+    file_id                        VARCHAR(40) NOT NULL,
+    position                       INTEGER NOT NULL,
+    record_type                    VARCHAR(1) NOT NULL,
+    payment_year                   INTEGER NOT NULL,
+    combined_fs_filing_program     VARCHAR(1),
+    payer_tin                      VARCHAR(9) NOT NULL,
+    payer_name_control             VARCHAR(4),
+    last_filing_indicator          VARCHAR(1),
+    type_of_return                 VARCHAR(2) NOT NULL,
+    amount_codes                   VARCHAR(16) NOT NULL,
+    foreign_entity_indicator       VARCHAR(1),
+    first_payer_name               VARCHAR(40) NOT NULL,
+    second_payer_name              VARCHAR(40),
+    transfer_agent_control         VARCHAR(1) NOT NULL,
+    payer_shipping_address         VARCHAR(40) NOT NULL,
+    payer_city                     VARCHAR(40) NOT NULL,
+    payer_state                    VARCHAR(2) NOT NULL,
+    payer_zip_code                 VARCHAR(9) NOT NULL,
+    payer_telephone_number_and_ext VARCHAR(15) NOT NULL,
+    record_sequence_number         INTEGER NOT NULL,
+
+    CONSTRAINT payers_pk PRIMARY KEY (payer_id),
+    CONSTRAINT payers_file_id_fk FOREIGN KEY (file_id) REFERENCES files (file_id)
+);
+
+CREATE INDEX payers_file_id_idx ON payers (file_id, position);
+
+CREATE TABLE end_payers (
+    payer_id               VARCHAR(40) NOT NULL,
+    record_type            VARCHAR(1) NOT NULL,
+    number_of_payees       INTEGER NOT NULL,
+    control_total_1        BIGINT,
+    control_total_2        BIGINT,
+    control_total_3        BIGINT,
+    control_total_4        BIGINT,
+    control_total_5        BIGINT,
+    control_total_6        BIGINT,
+    control_total_7        BIGINT,
+    control_total_8        BIGINT,
+    control_total_9        BIGINT,
+    control_total_A        BIGINT,
+    control_total_B        BIGINT,
+    control_total_C        BIGINT,
+    control_total_D        BIGINT,
+    control_total_E        BIGINT,
+    control_total_F        BIGINT,
+    control_total_G        BIGINT,
+    record_sequence_number INTEGER NOT NULL,
+
+    CONSTRAINT end_payers_pk PRIMARY KEY (payer_id),
+    CONSTRAINT end_payers_payer_id_fk FOREIGN KEY (payer_id) REFERENCES payers (payer_id)
+);
@@ -0,0 +1,42 @@
+CREATE TABLE payees (
+    payee_id                        VARCHAR(40) NOT NULL,
+    payer_id                        VARCHAR(40) NOT NULL,
+    position                        INTEGER NOT NULL,
+    record_type                     VARCHAR(1) NOT NULL,
+    payment_year                    INTEGER NOT NULL,
+    corrected_return_indicator      VARCHAR(1),
+    payees_name_control             VARCHAR(4),
+    type_of_tin                     VARCHAR(1),
+    payees_tin                      VARCHAR(9) NOT NULL,
+    payers_account_number_for_payee VARCHAR(20),
+    payers_office_code              VARCHAR(4),
+    payment_amount_1                BIGINT,
+    payment_amount_2                BIGINT,
+    payment_amount_3                BIGINT,
+    payment_amount_4                BIGINT,
+    payment_amount_5                BIGINT,
+    payment_amount_6                BIGINT,
+    payment_amount_7                BIGINT,
+    payment_amount_8                BIGINT,
+    payment_amount_9                BIGINT,
+    payment_amount_A                BIGINT,
+    payment_amount_B                BIGINT,
+    payment_amount_C                BIGINT,
+    payment_amount_D                BIGINT,
+    payment_amount_E                BIGINT,
+    payment_amount_F                BIGINT,
+    payment_amount_G                BIGINT,
+    foreign_country_indicator       VARCHAR(1),
+    first_payee_name_line           VARCHAR(40) NOT NULL,
+    second_payee_name_line          VARCHAR(40),
+    payee_mailing_address           VARCHAR(40) NOT NULL,
+    payee_city                      VARCHAR(40) NOT NULL,
+    payee_state                     VARCHAR(2) NOT NULL,
+    payee_zip_code                  VARCHAR(9) NOT NULL,
+    record_sequence_number          INTEGER NOT NULL,
+
+    CONSTRAINT payees_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_payer_id_fk FOREIGN KEY (payer_id) REFERENCES payers (payer_id)
+);
+
+CREATE INDEX payees_payer_id_idx ON payees (payer_id, position);
@@ -0,0 +1,408 @@
+CREATE TABLE payees_1097_btc (
+    payee_id             VARCHAR(40) NOT NULL,
+    issuer_indicator     VARCHAR(1) NOT NULL,
+    code                 VARCHAR(1) NOT NULL,
+    unique_identifier    VARCHAR(39),
+    bond_type            VARCHAR(3) NOT NULL,
+    special_data_entries VARCHAR(60),
+
+    CONSTRAINT payees_1097_btc_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1097_btc_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1098 (
+    payee_id                             VARCHAR(40) NOT NULL,
+    mortgage_origination_date            VARCHAR(8) NOT NULL,
+    property_securing_mortgage_indicator VARCHAR(1),
+    property_address_securing_mortgage   VARCHAR(39),
+    other                                VARCHAR(39),
+    number_of_mortgaged_properties       VARCHAR(4),
+    special_data_entries                 VARCHAR(60),
+    mortgage_acquisition_date            VARCHAR(8),
+
+    CONSTRAINT payees_1098_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1098_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1098_c (
+    payee_id                                      VARCHAR(40) NOT NULL,
+    transaction_indicator                         VARCHAR(1),
+    transfer_after_improvements_indicator         VARCHAR(1),
+    transfer_below_fair_market_value_indicator    VARCHAR(1),
+    intangible_religious_benefits_indicator       VARCHAR(1),
+    deduction_less_than_or_equal_to_500_indicator VARCHAR(1),
+    form_1098_c_odometer_mileage                  INTEGER,
+    year                                          VARCHAR(4) NOT NULL,
+    make                                          VARCHAR(13) NOT NULL,
+    model                                         VARCHAR(13) NOT NULL,
+    vehicle_or_other_identification_number        VARCHAR(25) NOT NULL,
+    vehicle_description                           VARCHAR(39),
+    date_of_contribution                          VARCHAR(8) NOT NULL,
+    date_of_sale                                  VARCHAR(8),
+    goods_and_services                            VARCHAR(54),
+
+    CONSTRAINT payees_1098_c_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1098_c_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1098_e (
+    payee_id                                        VARCHAR(40) NOT NULL,
+    origination_fees_capitalized_interest_indicator VARCHAR(1),
+    special_data_entries                            VARCHAR(60),
+
+    CONSTRAINT payees_1098_e_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1098_e_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1098_f (
+    payee_id                VARCHAR(40) NOT NULL,
+    date_of_order_agreement VARCHAR(8) NOT NULL,
+    jurisdiction            VARCHAR(39) NOT NULL,
+    case_number             VARCHAR(40),
+    case_name               VARCHAR(39),
+    payment_code            VARCHAR(8),
+
+    CONSTRAINT payees_1098_f_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1098_f_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1098_q (
+    payee_id                      VARCHAR(40) NOT NULL,
+    annuity_start_date            VARCHAR(8) NOT NULL,
+    start_date_may_be_accelerated VARCHAR(1),
+    date_of_birth                 VARCHAR(8) NOT NULL,
+    plan_name                     VARCHAR(40) NOT NULL,
+    plan_number                   VARCHAR(3),
+    plan_sponsor_ein              VARCHAR(9),
+    special_data_entries          VARCHAR(60),
+
+    CONSTRAINT payees_1098_q_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1098_q_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1098_t (
+    payee_id                    VARCHAR(40) NOT NULL,
+    half_time_student_indicator VARCHAR(1),
+    graduate_student_indicator  VARCHAR(1),
+    academic_period_indicator   VARCHAR(1),
+    special_data_entries        VARCHAR(60),
+
+    CONSTRAINT payees_1098_t_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1098_t_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_a (
+    payee_id                                                VARCHAR(40) NOT NULL,
+    personal_liability_indicator                            VARCHAR(1),
+    date_of_lenders_acquisition_or_knowledge_of_abandonment VARCHAR(8) NOT NULL,
+    description_of_property                                 VARCHAR(39),
+    special_data_entries                                    VARCHAR(60),
+
+    CONSTRAINT payees_1099_a_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_a_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_b (
+    payee_id                              VARCHAR(40) NOT NULL,
+    second_tin_notice                     VARCHAR(1),
+    noncovered_security_indicator         VARCHAR(1),
+    type_of_gain_or_loss_indicator        VARCHAR(1),
+    gross_proceeds_indicator              VARCHAR(1),
+    date_sold_or_disposed                 VARCHAR(8),
+    cusip_number                          VARCHAR(13),
+    description_of_property               VARCHAR(39),
+    date_acquired                         VARCHAR(8),
+    loss_not_allowed_indicator            VARCHAR(1),
+    applicable_check_box_of_form_8949     VARCHAR(1),
+    applicable_check_box_for_collectibles VARCHAR(1),
+    fatca_requirement_indicator           VARCHAR(1),
+    applicable_check_box_for_qof          VARCHAR(1),
+    special_data_entries                  VARCHAR(60),
+    state_income_tax_withheld             BIGINT,
+    local_income_tax_withheld             BIGINT,
+    combined_federal_state_code           INTEGER,
+
+    CONSTRAINT payees_1099_b_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_b_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_c (
+    payee_id                     VARCHAR(40) NOT NULL,
+    identifiable_event_code      VARCHAR(1) NOT NULL,
+    date_canceled                VARCHAR(8) NOT NULL,
+    debt_description             VARCHAR(39),
+    personal_liability_indicator VARCHAR(1),
+    special_data_entries         VARCHAR(60),
+
+    CONSTRAINT payees_1099_c_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_c_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_div (
+    payee_id                    VARCHAR(40) NOT NULL,
+    second_tin_notice           VARCHAR(1),
+    foreign_country             VARCHAR(40),
+    fatca_requirement_indicator VARCHAR(1),
+    special_data_entries        VARCHAR(60),
+    state_income_tax_withheld   BIGINT,
+    local_income_tax_withheld   BIGINT,
+    combined_federal_state_code INTEGER,
+
+    CONSTRAINT payees_1099_div_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_div_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_g (
+    payee_id                    VARCHAR(40) NOT NULL,
+    second_tin_notice           VARCHAR(1),
+    trade_or_business_indicator VARCHAR(1),
+    tax_year_of_refund          VARCHAR(4),
+    special_data_entries        VARCHAR(60),
+    state_income_tax_withheld   BIGINT,
+    local_income_tax_withheld   BIGINT,
+    combined_federal_state_code INTEGER,
+
+    CONSTRAINT payees_1099_g_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_g_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_int (
+    payee_id                    VARCHAR(40) NOT NULL,
+    second_tin_notice           VARCHAR(1),
+    foreign_country             VARCHAR(40),
+    cusip_number                VARCHAR(13),
+    fatca_requirement_indicator VARCHAR(1),
+    special_data_entries        VARCHAR(60),
+    state_income_tax_withheld   BIGINT,
+    local_income_tax_withheld   BIGINT,
+    combined_federal_state_code INTEGER,
+
+    CONSTRAINT payees_1099_int_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_int_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_k (
+    payee_id                                         VARCHAR(40) NOT NULL,
+    second_tin_notice                                VARCHAR(1),
+    filer_type_indicator                             VARCHAR(1) NOT NULL,
+    payment_indicator                                VARCHAR(1) NOT NULL,
+    number_of_payment_transactions                   BIGINT NOT NULL,
+    payment_settlement_entitys_name_and_phone_number VARCHAR(40),
+    merchant_category_code                           VARCHAR(4),
+    special_data_entries                             VARCHAR(60),
+    state_income_tax_withheld                        BIGINT,
+    local_income_tax_withheld                        BIGINT,
+    combined_federal_state_code                      INTEGER,
+
+    CONSTRAINT payees_1099_k_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_k_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_ltc (
+    payee_id                          VARCHAR(40) NOT NULL,
+    type_of_payment_indicator         VARCHAR(1),
+    social_security_number_of_insured VARCHAR(9) NOT NULL,
+    name_of_insured                   VARCHAR(40) NOT NULL,
+    address_of_insured                VARCHAR(40) NOT NULL,
+    city_of_insured                   VARCHAR(40) NOT NULL,
+    state_of_insured                  VARCHAR(2) NOT NULL,
+    zip_code_of_insured               VARCHAR(9) NOT NULL,
+    status_of_illness_indicator       VARCHAR(1),
+    date_certified                    VARCHAR(8),
+    qualified_contract_indicator      VARCHAR(1),
+    state_income_tax_withheld         BIGINT,
+    local_income_tax_withheld         BIGINT,
+
+    CONSTRAINT payees_1099_ltc_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_ltc_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_misc (
+    payee_id                    VARCHAR(40) NOT NULL,
+    second_tin_notice           VARCHAR(1),
+    direct_sales_indicator      VARCHAR(1),
+    fatca_requirement_indicator VARCHAR(1),
+    special_data_entries        VARCHAR(60),
+    state_income_tax_withheld   BIGINT,
+    local_income_tax_withheld   BIGINT,
+    combined_federal_state_code INTEGER,
+
+    CONSTRAINT payees_1099_misc_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_misc_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_nec (
+    payee_id                    VARCHAR(40) NOT NULL,
+    second_tin_notice           VARCHAR(1),
+    direct_sales_indicator      VARCHAR(1),
+    fatca_requirement_indicator VARCHAR(1),
+    special_data_entries        VARCHAR(60),
+    state_income_tax_withheld   BIGINT,
+    local_income_tax_withheld   BIGINT,
+    combined_federal_state_code INTEGER,
+
+    CONSTRAINT payees_1099_nec_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_nec_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_oid (
+    payee_id                    VARCHAR(40) NOT NULL,
+    second_tin_notice           VARCHAR(1),
+    direct_sales_indicator      VARCHAR(39),
+    fatca_requirement_indicator VARCHAR(1),
+    special_data_entries        VARCHAR(60),
+    state_income_tax_withheld   BIGINT,
+    local_income_tax_withheld   BIGINT,
+    combined_federal_state_code INTEGER,
+
+    CONSTRAINT payees_1099_oid_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_oid_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_patr (
+    payee_id                    VARCHAR(40) NOT NULL,
+    second_tin_notice           VARCHAR(1),
+    special_data_entries        VARCHAR(60),
+    state_income_tax_withheld   BIGINT,
+    local_income_tax_withheld   BIGINT,
+    combined_federal_state_code INTEGER,
+
+    CONSTRAINT payees_1099_patr_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_patr_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_q (
+    payee_id                              VARCHAR(40) NOT NULL,
+    trustee_to_trustee_transfer_indicator VARCHAR(1),
+    type_of_tuition_payment               VARCHAR(1),
+    designated_beneficiary                VARCHAR(1),
+    special_data_entries                  VARCHAR(60),
+
+    CONSTRAINT payees_1099_q_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_q_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_r (
+    payee_id                                   VARCHAR(40) NOT NULL,
+    second_tin_notice                          VARCHAR(1),
+    distribution_code                          VARCHAR(2) NOT NULL,
+    taxable_amount_not_determined_indicator    VARCHAR(1),
+    ira_sep_simple_indicator                   VARCHAR(1),
+    total_distribution_indicator               VARCHAR(1),
+    percentage_of_total_distribution           VARCHAR(2),
+    first_year_of_designated_roth_contribution VARCHAR(4),
+    fatca_requirement_indicator                VARCHAR(1),
+    date_of_payment                            VARCHAR(8),
+    special_data_entries                       VARCHAR(60),
+    state_income_tax_withheld                  BIGINT,
+    local_income_tax_withheld                  BIGINT,
+    combined_federal_state_code                INTEGER,
+
+    CONSTRAINT payees_1099_r_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_r_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_s (
+    payee_id                       VARCHAR(40) NOT NULL,
+    date_of_closing                VARCHAR(8) NOT NULL,
+    address_or_legal_description   VARCHAR(39) NOT NULL,
+    property_or_services_indicator VARCHAR(1),
+    foreign_transferor             VARCHAR(1),
+    special_data_entries           VARCHAR(60),
+
+    CONSTRAINT payees_1099_s_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_s_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_1099_sa (
+    payee_id                         VARCHAR(40) NOT NULL,
+    distribution_code                VARCHAR(1) NOT NULL,
+    medicare_advantage_msa_indicator VARCHAR(1),
+    hsa_indicator                    VARCHAR(1),
+    archer_msa_indicator             VARCHAR(1),
+    special_data_entries             VARCHAR(60),
+
+    CONSTRAINT payees_1099_sa_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_1099_sa_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_3921 (
+    payee_id                     VARCHAR(40) NOT NULL,
+    date_option_granted          VARCHAR(8) NOT NULL,
+    date_option_exercised        VARCHAR(8) NOT NULL,
+    number_of_shares_transferred INTEGER NOT NULL,
+    other_name                   VARCHAR(40),
+    other_address                VARCHAR(40),
+    other_city                   VARCHAR(40),
+    other_state                  VARCHAR(2),
+    other_zip_code               VARCHAR(9),
+
+    CONSTRAINT payees_3921_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_3921_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_3922 (
+    payee_id                     VARCHAR(40) NOT NULL,
+    date_option_granted          VARCHAR(8) NOT NULL,
+    date_option_exercised        VARCHAR(8) NOT NULL,
+    number_of_shares_transferred INTEGER NOT NULL,
+    date_legal_title_transferred VARCHAR(8),
+
+    CONSTRAINT payees_3922_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_3922_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_5498 (
+    payee_id                       VARCHAR(40) NOT NULL,
+    ira_indicator                  VARCHAR(1),
+    sep_indicator                  VARCHAR(1),
+    simple_indicator               VARCHAR(1),
+    roth_ira_indicator             VARCHAR(1),
+    rmd_indicator                  VARCHAR(1),
+    year_of_postponed_contribution VARCHAR(4),
+    postponed_contribution_code    VARCHAR(2),
+    postponed_contribution_reason  VARCHAR(6),
+    repayment_code                 VARCHAR(2),
+    rmd_date                       VARCHAR(8),
+    special_data_entries           VARCHAR(60),
+
+    CONSTRAINT payees_5498_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_5498_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_5498_esa (
+    payee_id             VARCHAR(40) NOT NULL,
+    special_data_entries VARCHAR(60),
+
+    CONSTRAINT payees_5498_esa_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_5498_esa_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_5498_sa (
+    payee_id                         VARCHAR(40) NOT NULL,
+    medicare_advantage_msa_indicator VARCHAR(1),
+    hsa_indicator                    VARCHAR(1),
+    archer_msa_indicator             VARCHAR(1),
+    special_data_entries             VARCHAR(60),
+
+    CONSTRAINT payees_5498_sa_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_5498_sa_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
+
+CREATE TABLE payees_w_2g (
+    payee_id                  VARCHAR(40) NOT NULL,
+    type_of_wager_code        VARCHAR(1) NOT NULL,
+    date_won                  VARCHAR(8) NOT NULL,
+    `transaction`             VARCHAR(15),
+    race                      VARCHAR(5),
+    cashier                   VARCHAR(5),
+    `window`                  VARCHAR(5),
+    first_id                  VARCHAR(15),
+    second_id                 VARCHAR(15),
+    special_data_entries      VARCHAR(60),
+    state_income_tax_withheld BIGINT,
+    local_income_tax_withheld BIGINT,
+
+    CONSTRAINT payees_w_2g_pk PRIMARY KEY (payee_id),
+    CONSTRAINT payees_w_2g_payee_id_fk FOREIGN KEY (payee_id) REFERENCES payees (payee_id)
+);
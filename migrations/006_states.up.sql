@@ -0,0 +1,29 @@
+CREATE TABLE states (
+    payer_id                        VARCHAR(40) NOT NULL,
+    position                        INTEGER NOT NULL,
+    record_type                     VARCHAR(1) NOT NULL,
+    number_of_payees                INTEGER NOT NULL,
+    control_total_1                 BIGINT,
+    control_total_2                 BIGINT,
+    control_total_3                 BIGINT,
+    control_total_4                 BIGINT,
+    control_total_5                 BIGINT,
+    control_total_6                 BIGINT,
+    control_total_7                 BIGINT,
+    control_total_8                 BIGINT,
+    control_total_9                 BIGINT,
+    control_total_A                 BIGINT,
+    control_total_B                 BIGINT,
+    control_total_C                 BIGINT,
+    control_total_D                 BIGINT,
+    control_total_E                 BIGINT,
+    control_total_F                 BIGINT,
+    control_total_G                 BIGINT,
+    record_sequence_number          INTEGER NOT NULL,
+    state_income_tax_withheld_total BIGINT,
+    local_income_tax_withheld_total BIGINT,
+    combined_federal_state_code     INTEGER NOT NULL,
+
+    CONSTRAINT states_pk PRIMARY KEY (payer_id, position),
+    CONSTRAINT states_payer_id_fk FOREIGN KEY (payer_id) REFERENCES payers (payer_id)
+);
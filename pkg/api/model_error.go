@@ -0,0 +1,10 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package api
+
+// Error - Describes why a request couldn't be processed
+type Error struct {
+	Error string `json:"error"`
+}
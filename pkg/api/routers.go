@@ -0,0 +1,81 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/identity/pkg/logging"
+)
+
+// A Route defines the parameters for an api endpoint
+type Route struct {
+	Name        string
+	Method      string
+	Pattern     string
+	HandlerFunc http.HandlerFunc
+}
+
+// Routes are a collection of defined api endpoints
+type Routes []Route
+
+// Router defines the required methods for retrieving api routes
+type Router interface {
+	Routes() Routes
+}
+
+// AppendRouters creates a new router for any number of api routers
+func AppendRouters(log logging.Logger, appendTo *mux.Router, routers ...Router) *mux.Router {
+	router := appendTo.StrictSlash(true)
+	for _, api := range routers {
+		for _, route := range api.Routes() {
+			var handler http.Handler
+			handler = route.HandlerFunc
+			handler = Logger(log, handler, route.Name)
+
+			router.
+				Methods(route.Method).
+				Path(route.Pattern).
+				Name(route.Name).
+				Handler(handler)
+		}
+	}
+
+	return router
+}
+
+// Logger logs method, uri and response time of requests of the route
+func Logger(log logging.Logger, inner http.Handler, name string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		inner.ServeHTTP(w, r)
+
+		log.WithMap(map[string]string{
+			"request_method": r.Method,
+			"request_uri":    r.RequestURI,
+			"route_name":     name,
+			"response_time":  time.Since(start).String(),
+		}).Info().Log(fmt.Sprintf("%s %s %s", r.Method, r.RequestURI, name))
+	})
+}
+
+// EncodeJSONResponse uses the json encoder to write an interface to the http response with an optional status code
+func EncodeJSONResponse(i interface{}, status *int, w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	if status != nil {
+		w.WriteHeader(*status)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	e := json.NewEncoder(w)
+	e.SetIndent("", "  ")
+	return e.Encode(i)
+}
@@ -0,0 +1,25 @@
+/*
+ * IRS API
+ *
+ * Package github.com/moov-io/irs implements a file reader and writer written in Go along with a HTTP API and CLI for creating, parsing, validating, and transforming IRS electronic Filing Information Returns Electronically (FIRE). FIRE operates on a byte(ASCII) level making it difficult to interface with JSON and CSV/TEXT file formats. | Input      | Output     | |------------|------------| | JSON       | JSON       | | ASCII FIRE | ASCII FIRE | | CSV        | CSV        | |            | PDF Form   | |            | SQL        |
+ *
+ * API version: 0.0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package client
+
+// FieldError Problem of a record or a field of a record
+type FieldError struct {
+	RecordType           string `json:"record_type,omitempty"`
+	RecordSequenceNumber int32  `json:"record_sequence_number,omitempty"`
+	// Row of an imported file, such as a CSV file, that the record was read from
+	Row         int32  `json:"row,omitempty"`
+	FieldName   string `json:"field_name,omitempty"`
+	StartColumn int32  `json:"start_column,omitempty"`
+	EndColumn   int32  `json:"end_column,omitempty"`
+	Value       string `json:"value,omitempty"`
+	// Stable code of the problem
+	Code    string `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,19 @@
+/*
+ * IRS API
+ *
+ * Package github.com/moov-io/irs implements a file reader and writer written in Go along with a HTTP API and CLI for creating, parsing, validating, and transforming IRS electronic Filing Information Returns Electronically (FIRE). FIRE operates on a byte(ASCII) level making it difficult to interface with JSON and CSV/TEXT file formats. | Input      | Output     | |------------|------------| | JSON       | JSON       | | ASCII FIRE | ASCII FIRE | | CSV        | CSV        | |            | PDF Form   | |            | SQL        |
+ *
+ * API version: 0.0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package client
+
+// File FIRE file of a transmitter with its payers and payees
+type File struct {
+	// Transmitter “T” record
+	Transmitter    map[string]interface{} `json:"transmitter,omitempty"`
+	PaymentPersons []FilePaymentPersons   `json:"payment_persons,omitempty"`
+	// End of transmission “F” record
+	EndTransmitter map[string]interface{} `json:"end_transmitter,omitempty"`
+}
@@ -0,0 +1,23 @@
+/*
+ * IRS API
+ *
+ * Package github.com/moov-io/irs implements a file reader and writer written in Go along with a HTTP API and CLI for creating, parsing, validating, and transforming IRS electronic Filing Information Returns Electronically (FIRE). FIRE operates on a byte(ASCII) level making it difficult to interface with JSON and CSV/TEXT file formats. | Input      | Output     | |------------|------------| | JSON       | JSON       | | ASCII FIRE | ASCII FIRE | | CSV        | CSV        | |            | PDF Form   | |            | SQL        |
+ *
+ * API version: 0.0.1
+ * Generated by: OpenAPI Generator (https://openapi-generator.tech)
+ */
+
+package client
+
+// StateRecord State totals “K” record of payees reported for the Combined Federal/State Filing Program. Withholding totals and the CF/SF code are integers, as the same fields of payee “B” records.
+type StateRecord struct {
+	RecordType           string `json:"record_type,omitempty"`
+	NumberOfPayees       int32  `json:"number_of_payees,omitempty"`
+	RecordSequenceNumber int32  `json:"record_sequence_number,omitempty"`
+	// Aggregate total of state income tax withheld of the payees, blank filled when zero
+	StateIncomeTaxWithheldTotal int32 `json:"state_income_tax_withheld_total,omitempty"`
+	// Aggregate total of local income tax withheld of the payees, blank filled when zero
+	LocalIncomeTaxWithheldTotal int32 `json:"local_income_tax_withheld_total,omitempty"`
+	// CF/SF code assigned to the state which is to receive the information
+	CombinedFederalStateCode int32 `json:"combined_federal_state_code,omitempty"`
+}
@@ -19,14 +19,62 @@ const (
 	FRecordType = "F"
 	// Sub1097BtcType indicates type of payee “B” record for form 1097-BTC
 	Sub1097BtcType = "1097-BTC"
+	// Sub1098Type indicates type of payee “B” record for form 1098
+	Sub1098Type = "1098"
+	// Sub1098CType indicates type of payee “B” record for form 1098-C
+	Sub1098CType = "1098-C"
+	// Sub1098EType indicates type of payee “B” record for form 1098-E
+	Sub1098EType = "1098-E"
+	// Sub1098FType indicates type of payee “B” record for form 1098-F
+	Sub1098FType = "1098-F"
+	// Sub1098QType indicates type of payee “B” record for form 1098-Q
+	Sub1098QType = "1098-Q"
+	// Sub1098TType indicates type of payee “B” record for form 1098-T
+	Sub1098TType = "1098-T"
+	// Sub1099AType indicates type of payee “B” record for form 1099-A
+	Sub1099AType = "1099-A"
+	// Sub1099BType indicates type of payee “B” record for form 1099-B
+	Sub1099BType = "1099-B"
+	// Sub1099CType indicates type of payee “B” record for form 1099-C
+	Sub1099CType = "1099-C"
+	// Sub1099DivType indicates type of payee “B” record for form 1099-DIV
+	Sub1099DivType = "1099-DIV"
+	// Sub1099GType indicates type of payee “B” record for form 1099-G
+	Sub1099GType = "1099-G"
 	// Sub1099IntType indicates type of payee “B” record for form 1099-INT
 	Sub1099IntType = "1099-INT"
+	// Sub1099KType indicates type of payee “B” record for form 1099-K
+	Sub1099KType = "1099-K"
+	// Sub1099LtcType indicates type of payee “B” record for form 1099-LTC
+	Sub1099LtcType = "1099-LTC"
 	// Sub1099MiscType indicates type of payee “B” record for form 1099-MISC
 	Sub1099MiscType = "1099-MISC"
+	// Sub1099NecType indicates type of payee “B” record for form 1099-NEC
+	Sub1099NecType = "1099-NEC"
 	// Sub1099OidType indicates type of payee “B” record for form 1099-OID
 	Sub1099OidType = "1099-OID"
 	// Sub1099PatrType indicates type of payee “B” record for form 1099-PATR
 	Sub1099PatrType = "1099-PATR"
+	// Sub1099QType indicates type of payee “B” record for form 1099-Q
+	Sub1099QType = "1099-Q"
+	// Sub1099RType indicates type of payee “B” record for form 1099-R
+	Sub1099RType = "1099-R"
+	// Sub1099SType indicates type of payee “B” record for form 1099-S
+	Sub1099SType = "1099-S"
+	// Sub1099SaType indicates type of payee “B” record for form 1099-SA
+	Sub1099SaType = "1099-SA"
+	// Sub5498Type indicates type of payee “B” record for form 5498
+	Sub5498Type = "5498"
+	// Sub5498EsaType indicates type of payee “B” record for form 5498-ESA
+	Sub5498EsaType = "5498-ESA"
+	// Sub5498SaType indicates type of payee “B” record for form 5498-SA
+	Sub5498SaType = "5498-SA"
+	// Sub3921Type indicates type of payee “B” record for form 3921
+	Sub3921Type = "3921"
+	// Sub3922Type indicates type of payee “B” record for form 3922
+	Sub3922Type = "3922"
+	// SubW2GType indicates type of payee “B” record for form W-2G
+	SubW2GType = "W-2G"
 )
 
 const (
@@ -36,6 +84,11 @@ const (
 	SubRecordLength = 207
 )
 
+const (
+	// DateFormat indicates the format of date fields (YYYYMMDD)
+	DateFormat = "20060102"
+)
+
 const (
 	// BlankString indicates the empty string
 	BlankString = " "
@@ -56,6 +109,10 @@ const (
 	VendorIndicatorProduced = "I"
 	// FSFilingProgramApproved indicates  approved and submitting information as part of the CF/SF Program
 	FSFilingProgramApproved = "1"
+	// NecPaymentYear is the first payment year of nonemployee compensation reported by 1099-NEC instead of amount code 7 of 1099-MISC
+	NecPaymentYear = 2020
+	// MiscNecAmountCode is the amount code of nonemployee compensation of 1099-MISC before NecPaymentYear
+	MiscNecAmountCode = "7"
 	// LastFilingIndicator indicates this is the last year this payer name and TIN will file information returns electronically or on paper
 	LastFilingIndicator = "1"
 	// The entity in the Second Payer Name Line Field is the transfer (or paying) agent
@@ -81,8 +138,64 @@ const (
 	// consumer products to a person on a buy-sell, depositcommission, or any other commission basis for resale
 	// anywhere other than in a permanent retail establishment
 	DirectSalesIndicator = "1"
+	// NoncoveredSecurityIndicator indicates noncovered securities
+	NoncoveredSecurityIndicator = "2"
+	// LossNotAllowedIndicator indicates the recipient is unable to claim a loss on their tax return
+	LossNotAllowedIndicator = "1"
+	// CollectiblesIndicator indicates the sale of a collectible
+	CollectiblesIndicator = "1"
+	// QOFIndicator indicates proceeds from a Qualified Opportunity Fund
+	QOFIndicator = "1"
+	// TaxableAmountNotDeterminedIndicator indicates the taxable amount of the distribution is not determined
+	TaxableAmountNotDeterminedIndicator = "1"
+	// IRASEPSIMPLEIndicator indicates the distribution is from a traditional IRA, SEP or SIMPLE
+	IRASEPSIMPLEIndicator = "1"
+	// TotalDistributionIndicator indicates the distribution is a total distribution
+	TotalDistributionIndicator = "1"
+	// PropertySecuringMortgageIndicator indicates the address of the property securing the mortgage is the same as the payer/borrower’s address
+	PropertySecuringMortgageIndicator = "1"
+	// OriginationFeesIndicator indicates the amount includes loan origination fees and/or capitalized interest
+	OriginationFeesIndicator = "1"
+	// HalfTimeStudentIndicator indicates the student was at least a half-time student
+	HalfTimeStudentIndicator = "1"
+	// GraduateStudentIndicator indicates the student is enrolled exclusively in a graduate level program
+	GraduateStudentIndicator = "1"
+	// AcademicPeriodIndicator indicates the amount includes amounts for an academic period beginning in the following year
+	AcademicPeriodIndicator = "1"
+	// VehicleDonationIndicator indicates a checked box of 1098-C
+	VehicleDonationIndicator = "1"
+	// StartDateMayBeAcceleratedIndicator indicates the annuity start date may be accelerated
+	StartDateMayBeAcceleratedIndicator = "1"
+	// AccountTypeIndicator indicates the type of account that contributions are reported for
+	AccountTypeIndicator = "1"
+	// RMDIndicator indicates reporting RMD for the following year
+	RMDIndicator = "1"
+	// PersonalLiabilityIndicator indicates the borrower was personally liable for repayment of the debt
+	PersonalLiabilityIndicator = "1"
+	// PropertyOrServicesIndicator indicates the transferor received property or services as part of the consideration
+	PropertyOrServicesIndicator = "1"
+	// ForeignTransferorIndicator indicates the transferor is a foreign person
+	ForeignTransferorIndicator = "1"
+	// TradeOrBusinessIndicator indicates the refund is attributable to income tax that applies exclusively to income from a trade or business
+	TradeOrBusinessIndicator = "1"
+	// TrusteeToTrusteeIndicator indicates reporting a trustee-to-trustee transfer
+	TrusteeToTrusteeIndicator = "1"
+	// DesignatedBeneficiaryIndicator indicates the recipient is not the designated beneficiary
+	DesignatedBeneficiaryIndicator = "1"
+	// QualifiedContractIndicator indicates benefits were from a qualified long-term care insurance contract
+	QualifiedContractIndicator = "1"
 )
 
+// Amount codes of payment amount fields in the order of payee “B” record
+var PaymentAmountCodes = []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "A", "B", "C", "D", "E", "F", "G"}
+
+// Types of return that may report negative payment amounts, for items that reflect a loss
+var NegativeAmountReturns = map[string]bool{
+	Sub1099BType:   true,
+	Sub1099OidType: true,
+	Sub1099QType:   true,
+}
+
 // State Abbreviation Codes
 var StateAbbreviationCodes = map[string]string{
 	"AL": "Alabama",
@@ -198,6 +311,7 @@ var TypeOfReturns = map[string]string{
 	"LC": "1099-LS",
 	"T":  "1099-LTC",
 	"A":  "1099-MISC",
+	"NE": "1099-NEC",
 	"D":  "1099-OID",
 	"7":  "1099-PATR",
 	"Q":  "1099-Q",
@@ -232,6 +346,181 @@ var BtcBondType = map[string]string{
 	"199": "Other",
 }
 
+// Available types of gain or loss for 1099-B
+var GainLossIndicators = map[string]string{
+	"1": "Short-term gain or loss",
+	"2": "Long-term gain or loss",
+	"3": "Ordinary",
+}
+
+// Available gross proceeds indicators for 1099-B
+var GrossProceedsIndicators = map[string]string{
+	"1": "Gross proceeds",
+	"2": "Gross proceeds less commissions and option premiums",
+}
+
+// Available check boxes of Form 8949 for 1099-B
+var Form8949CheckBoxes = map[string]string{
+	"A": "Short-term transaction for which basis was reported to the IRS",
+	"B": "Short-term transaction for which basis was not reported to the IRS",
+	"D": "Long-term transaction for which basis was reported to the IRS",
+	"E": "Long-term transaction for which basis was not reported to the IRS",
+	"X": "If unable to determine whether short-term or long-term",
+}
+
+// Available payment codes for 1098-F
+var FinesPaymentCodes = map[string]string{
+	"A": "Multiple payers/defendants",
+	"B": "Multiple payees",
+	"C": "Property included in settlement",
+	"D": "Settlement paid in full as of time of filing",
+	"E": "No payment received as of time of filing",
+	"F": "Deferred prosecution agreement",
+	"G": "Non-prosecution agreement",
+	"H": "Other",
+}
+
+// Available postponed contribution codes for 5498
+var PostponedContributionCodes = map[string]string{
+	"FD": "Federally designated disaster area",
+	"PL": "Public law",
+	"EO": "Executive order",
+}
+
+// Available repayment codes for 5498
+var RepaymentCodes = map[string]string{
+	"QR": "Qualified reservist distribution",
+	"DD": "Federally designated disaster distribution",
+	"BA": "Qualified birth or adoption distribution",
+}
+
+// Available identifiable event codes for 1099-C
+var IdentifiableEventCodes = map[string]string{
+	"A": "Bankruptcy",
+	"B": "Other judicial debt relief",
+	"C": "Statute of limitations or expiration of deficiency period",
+	"D": "Foreclosure election",
+	"E": "Debt relief from probate or similar proceeding",
+	"F": "By agreement",
+	"G": "Decision or policy to discontinue collection",
+	"H": "Other actual discharge before identifiable event",
+}
+
+// Available types of tuition payment for 1099-Q
+var TuitionPaymentTypes = map[string]string{
+	"1": "Private program payment",
+	"2": "State program payment",
+	"3": "Coverdell ESA contribution",
+}
+
+// Available distribution codes for 1099-SA
+var SADistributionCodes = map[string]string{
+	"1": "Normal distribution",
+	"2": "Excess contributions",
+	"3": "Disability",
+	"4": "Death distribution other than code 6",
+	"5": "Prohibited transaction",
+	"6": "Death distribution after year of death to a nonspouse beneficiary",
+}
+
+// Available types of payment for 1099-LTC
+var LTCPaymentTypes = map[string]string{
+	"1": "Per diem",
+	"2": "Reimbursed amount",
+}
+
+// Available statuses of illness for 1099-LTC
+var IllnessStatuses = map[string]string{
+	"1": "Chronically ill",
+	"2": "Terminally ill",
+}
+
+// Available distribution codes for 1099-R
+var DistributionCodes = map[string]string{
+	"1": "Early distribution, no known exception",
+	"2": "Early distribution, exception applies",
+	"3": "Disability",
+	"4": "Death",
+	"5": "Prohibited transaction",
+	"6": "Section 1035 exchange",
+	"7": "Normal distribution",
+	"8": "Excess contributions plus earnings/excess deferrals taxable in current year",
+	"9": "Cost of current life insurance protection",
+	"A": "May be eligible for 10-year tax option",
+	"B": "Designated Roth account distribution",
+	"C": "Reportable death benefits under section 6050Y",
+	"D": "Annuity payments from nonqualified annuities",
+	"E": "Distributions under Employee Plans Compliance Resolution System",
+	"F": "Charitable gift annuity",
+	"G": "Direct rollover and direct payment",
+	"H": "Direct rollover of a designated Roth account distribution to a Roth IRA",
+	"J": "Early distribution from a Roth IRA",
+	"K": "Distribution of traditional IRA assets not having a readily available FMV",
+	"L": "Loans treated as deemed distributions under section 72(p)",
+	"M": "Qualified plan loan offset",
+	"N": "Recharacterized IRA contribution made for current year",
+	"P": "Excess contributions plus earnings/excess deferrals taxable in prior year",
+	"Q": "Qualified distribution from a Roth IRA",
+	"R": "Recharacterized IRA contribution made for prior year",
+	"S": "Early distribution from a SIMPLE IRA in first 2 years, no known exception",
+	"T": "Roth IRA distribution, exception applies",
+	"U": "Dividend distribution from ESOP under section 404(k)",
+	"W": "Charges or payments for purchasing qualified long-term care insurance contracts",
+}
+
+// Valid combinations of distribution codes for 1099-R,
+// distribution codes that are not listed cannot be combined with another code
+var DistributionCodeCombinations = map[string][]string{
+	"1": {"8", "B", "D", "K", "L", "M", "P"},
+	"2": {"8", "B", "D", "K", "L", "M", "P"},
+	"3": {"D"},
+	"4": {"8", "A", "B", "D", "G", "H", "K", "L", "M", "P"},
+	"6": {"W"},
+	"7": {"A", "B", "D", "K", "L", "M"},
+	"8": {"1", "2", "4", "B", "J", "K"},
+	"A": {"4", "7"},
+	"B": {"1", "2", "4", "7", "8", "G", "L", "M", "P", "U"},
+	"C": {"D"},
+	"D": {"1", "2", "3", "4", "7", "C"},
+	"G": {"4", "B", "K"},
+	"H": {"4"},
+	"J": {"8", "P"},
+	"K": {"1", "2", "4", "7", "8", "G"},
+	"L": {"1", "2", "4", "7", "B"},
+	"M": {"1", "2", "4", "7", "B"},
+	"P": {"1", "2", "4", "B", "J"},
+	"U": {"B"},
+	"W": {"6"},
+}
+
+// Available filer types for 1099-K
+var FilerTypes = map[string]string{
+	"1": "Payment Settlement Entity (PSE)",
+	"2": "Electronic Payment Facilitator (EPF)/Other third party",
+}
+
+// Available types of payment for 1099-K
+var PaymentIndicators = map[string]string{
+	"1": "Payment card payment",
+	"2": "Third party network payment",
+}
+
+// Amount codes of monthly payments for 1099-K, that must sum to the gross amount (Amount Code 1)
+var MonthlyAmountCodes = []string{"5", "6", "7", "8", "9", "A", "B", "C", "D", "E", "F", "G"}
+
+// Available type of wager codes for W-2G
+var TypeOfWagerCodes = map[string]string{
+	"1": "Horse race track (or off-track betting of a horse track nature)",
+	"2": "Dog race track (or off-track betting of a dog track nature)",
+	"3": "Jai-alai",
+	"4": "State conducted lottery",
+	"5": "Keno",
+	"6": "Bingo",
+	"7": "Slot machines",
+	"8": "Poker winnings",
+	"9": "Any other type of gambling winnings",
+}
+
 // Amount codes for the type of return being reported.
 var AmountCodes = map[string]map[string]string{
 	"1097-BTC": {
@@ -411,6 +700,10 @@ var AmountCodes = map[string]map[string]string{
 		"D": "Section 409A deferrals",
 		"E": "Section 409A income",
 	},
+	"1099-NEC": {
+		"1": "Nonemployee compensation",
+		"4": "Federal income tax withheld",
+	},
 	"1099-OID": {
 		"1": "Original issue discount for 2019",
 		"2": "Other periodic interest",
@@ -32,6 +32,8 @@ const (
 	TelephoneNumber
 	Email
 	DateYear
+	Date
+	ZeroNumericSigned
 )
 
 var (
@@ -39,7 +41,7 @@ var (
 	TRecordLayout = map[string]SpecField{
 		"RecordType":                   {0, 1, Alphanumeric, Required},
 		"PaymentYear":                  {1, 4, DateYear, Required},
-		"PriorYearDataIndicator":       {5, 1, Alphanumeric, Required},
+		"PriorYearDataIndicator":       {5, 1, Alphanumeric, Applicable},
 		"TIN":                          {6, 9, Numeric, Required},
 		"TCC":                          {15, 5, Alphanumeric, Required},
 		"Blank1":                       {20, 7, Alphanumeric, Nullable},
@@ -100,7 +102,7 @@ var (
 		"Blank4":                  {507, 241, Alphanumeric, Nullable},
 		"Blank5":                  {748, 2, Alphanumeric, Nullable},
 	}
-	// Payee “B” Record
+	// Payee “B” Record, payment amounts of amount codes that aren't reported by the payer are zero
 	BRecordLayout = map[string]SpecField{
 		"RecordType":               {0, 1, Alphanumeric, Required},
 		"PaymentYear":              {1, 4, DateYear, Required},
@@ -111,22 +113,22 @@ var (
 		"PayerAccountNumber":       {20, 20, Alphanumeric, Applicable},
 		"PayerOfficeCode":          {40, 4, Alphanumeric, Applicable},
 		"Blank1":                   {44, 10, Alphanumeric, Nullable},
-		"PaymentAmount1":           {54, 12, ZeroNumeric, Required},
-		"PaymentAmount2":           {66, 12, ZeroNumeric, Required},
-		"PaymentAmount3":           {78, 12, ZeroNumeric, Required},
-		"PaymentAmount4":           {90, 12, ZeroNumeric, Required},
-		"PaymentAmount5":           {102, 12, ZeroNumeric, Required},
-		"PaymentAmount6":           {114, 12, ZeroNumeric, Required},
-		"PaymentAmount7":           {126, 12, ZeroNumeric, Required},
-		"PaymentAmount8":           {138, 12, ZeroNumeric, Required},
-		"PaymentAmount9":           {150, 12, ZeroNumeric, Required},
-		"PaymentAmountA":           {162, 12, ZeroNumeric, Required},
-		"PaymentAmountB":           {174, 12, ZeroNumeric, Required},
-		"PaymentAmountC":           {186, 12, ZeroNumeric, Required},
-		"PaymentAmountD":           {198, 12, ZeroNumeric, Required},
-		"PaymentAmountE":           {210, 12, ZeroNumeric, Required},
-		"PaymentAmountF":           {222, 12, ZeroNumeric, Required},
-		"PaymentAmountG":           {234, 12, ZeroNumeric, Required},
+		"PaymentAmount1":           {54, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmount2":           {66, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmount3":           {78, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmount4":           {90, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmount5":           {102, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmount6":           {114, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmount7":           {126, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmount8":           {138, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmount9":           {150, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmountA":           {162, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmountB":           {174, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmountC":           {186, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmountD":           {198, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmountE":           {210, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmountF":           {222, 12, ZeroNumericSigned, Applicable},
+		"PaymentAmountG":           {234, 12, ZeroNumericSigned, Applicable},
 		"ForeignCountryIndicator":  {246, 1, Alphanumeric, Applicable},
 		"FirstPayeeNameLine":       {247, 40, Alphanumeric, Required},
 		"SecondPayeeNameLine":      {287, 40, Alphanumeric, Applicable},
@@ -141,60 +143,60 @@ var (
 		"Blank5":                   {507, 36, Alphanumeric, Nullable},
 		"Reserved":                 {543, 207, Alphanumeric, Expandable},
 	}
-	// End of Payer “C” Record
+	// End of Payer “C” Record, control totals of amount codes that aren't reported by the payer are zero
 	CRecordLayout = map[string]SpecField{
 		"RecordType":           {0, 1, Alphanumeric, Required},
 		"NumberPayees":         {1, 8, ZeroNumeric, Required},
 		"Blank1":               {9, 6, Alphanumeric, Nullable},
-		"ControlTotal1":        {15, 18, ZeroNumeric, Required},
-		"ControlTotal2":        {33, 18, ZeroNumeric, Required},
-		"ControlTotal3":        {51, 18, ZeroNumeric, Required},
-		"ControlTotal4":        {69, 18, ZeroNumeric, Required},
-		"ControlTotal5":        {87, 18, ZeroNumeric, Required},
-		"ControlTotal6":        {105, 18, ZeroNumeric, Required},
-		"ControlTotal7":        {123, 18, ZeroNumeric, Required},
-		"ControlTotal8":        {141, 18, ZeroNumeric, Required},
-		"ControlTotal9":        {159, 18, ZeroNumeric, Required},
-		"ControlTotalA":        {177, 18, ZeroNumeric, Required},
-		"ControlTotalB":        {195, 18, ZeroNumeric, Required},
-		"ControlTotalC":        {213, 18, ZeroNumeric, Required},
-		"ControlTotalD":        {231, 18, ZeroNumeric, Required},
-		"ControlTotalE":        {249, 18, ZeroNumeric, Required},
-		"ControlTotalF":        {267, 18, ZeroNumeric, Required},
-		"ControlTotalG":        {285, 18, ZeroNumeric, Required},
+		"ControlTotal1":        {15, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal2":        {33, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal3":        {51, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal4":        {69, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal5":        {87, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal6":        {105, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal7":        {123, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal8":        {141, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal9":        {159, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalA":        {177, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalB":        {195, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalC":        {213, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalD":        {231, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalE":        {249, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalF":        {267, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalG":        {285, 18, ZeroNumericSigned, Applicable},
 		"Blank2":               {303, 196, Alphanumeric, Nullable},
 		"RecordSequenceNumber": {499, 8, ZeroNumeric, Required},
 		"Blank3":               {507, 241, Alphanumeric, Nullable},
 		"Blank4":               {748, 2, Alphanumeric, Nullable},
 	}
-	// State Totals “K” Record
+	// State Totals “K” Record, control totals of amount codes that aren't reported by the payer are zero
 	KRecordLayout = map[string]SpecField{
 		"RecordType":                  {0, 1, Alphanumeric, Required},
 		"NumberPayees":                {1, 8, ZeroNumeric, Required},
 		"Blank1":                      {9, 6, Alphanumeric, Nullable},
-		"ControlTotal1":               {15, 18, ZeroNumeric, Required},
-		"ControlTotal2":               {33, 18, ZeroNumeric, Required},
-		"ControlTotal3":               {51, 18, ZeroNumeric, Required},
-		"ControlTotal4":               {69, 18, ZeroNumeric, Required},
-		"ControlTotal5":               {87, 18, ZeroNumeric, Required},
-		"ControlTotal6":               {105, 18, ZeroNumeric, Required},
-		"ControlTotal7":               {123, 18, ZeroNumeric, Required},
-		"ControlTotal8":               {141, 18, ZeroNumeric, Required},
-		"ControlTotal9":               {159, 18, ZeroNumeric, Required},
-		"ControlTotalA":               {177, 18, ZeroNumeric, Required},
-		"ControlTotalB":               {195, 18, ZeroNumeric, Required},
-		"ControlTotalC":               {213, 18, ZeroNumeric, Required},
-		"ControlTotalD":               {231, 18, ZeroNumeric, Required},
-		"ControlTotalE":               {249, 18, ZeroNumeric, Required},
-		"ControlTotalF":               {267, 18, ZeroNumeric, Required},
-		"ControlTotalG":               {285, 18, ZeroNumeric, Required},
+		"ControlTotal1":               {15, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal2":               {33, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal3":               {51, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal4":               {69, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal5":               {87, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal6":               {105, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal7":               {123, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal8":               {141, 18, ZeroNumericSigned, Applicable},
+		"ControlTotal9":               {159, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalA":               {177, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalB":               {195, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalC":               {213, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalD":               {231, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalE":               {249, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalF":               {267, 18, ZeroNumericSigned, Applicable},
+		"ControlTotalG":               {285, 18, ZeroNumericSigned, Applicable},
 		"Blank2":                      {303, 196, Alphanumeric, Nullable},
 		"RecordSequenceNumber":        {499, 8, ZeroNumeric, Required},
 		"Blank3":                      {507, 199, Alphanumeric, Nullable},
 		"StateIncomeTaxWithheldTotal": {706, 18, Numeric, Applicable},
 		"LocalIncomeTaxWithheldTotal": {724, 18, Numeric, Applicable},
 		"Blank4":                      {742, 4, Alphanumeric, Nullable},
-		"CombinedFederalStateCode":    {746, 2, Alphanumeric, Required},
+		"CombinedFederalStateCode":    {746, 2, ZeroNumeric, Required},
 		"Blank5":                      {748, 2, Alphanumeric, Nullable},
 	}
 	// End of Transmission “F” Record
@@ -226,6 +228,156 @@ var (
 		"Blank5":             {179, 26, Alphanumeric, Nullable},
 		"Blank6":             {205, 2, Alphanumeric, Nullable},
 	}
+	// Record Layout Positions 544-750 for Form 1098
+	Sub1098Layout = map[string]SpecField{
+		"Blank1":                            {0, 3, Alphanumeric, Nullable},
+		"MortgageOriginationDate":           {3, 8, Date, Required},
+		"PropertySecuringMortgageIndicator": {11, 1, Alphanumeric, Applicable},
+		"PropertyAddress":                   {12, 39, Alphanumeric, Applicable},
+		"Other":                             {51, 39, Alphanumeric, Applicable},
+		"Blank2":                            {90, 1, Alphanumeric, Nullable},
+		"NumberMortgagedProperties":         {91, 4, Numeric, Applicable},
+		"SpecialDataEntries":                {95, 60, Alphanumeric, Applicable},
+		"MortgageAcquisitionDate":           {155, 8, Date, Applicable},
+		"Blank3":                            {163, 42, Alphanumeric, Nullable},
+		"Blank4":                            {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1098-C
+	Sub1098CLayout = map[string]SpecField{
+		"Blank1":                               {0, 3, Alphanumeric, Nullable},
+		"TransactionIndicator":                 {3, 1, Alphanumeric, Applicable},
+		"TransferAfterImprovementsIndicator":   {4, 1, Alphanumeric, Applicable},
+		"TransferBelowFMVIndicator":            {5, 1, Alphanumeric, Applicable},
+		"IntangibleReligiousBenefitsIndicator": {6, 1, Alphanumeric, Applicable},
+		"DeductionLessThan500Indicator":        {7, 1, Alphanumeric, Applicable},
+		"OdometerMileage":                      {8, 7, ZeroNumeric, Applicable},
+		"Year":                                 {15, 4, Numeric, Required},
+		"Make":                                 {19, 13, Alphanumeric, Required},
+		"Model":                                {32, 13, Alphanumeric, Required},
+		"VehicleIdentificationNumber":          {45, 25, Alphanumeric, Required},
+		"VehicleDescription":                   {70, 39, Alphanumeric, Applicable},
+		"DateOfContribution":                   {109, 8, Date, Required},
+		"DateOfSale":                           {117, 8, Date, Applicable},
+		"GoodsAndServices":                     {125, 54, Alphanumeric, Applicable},
+		"Blank2":                               {179, 26, Alphanumeric, Nullable},
+		"Blank3":                               {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1098-E
+	Sub1098ELayout = map[string]SpecField{
+		"Blank1":                   {0, 1, Alphanumeric, Nullable},
+		"OriginationFeesIndicator": {1, 1, Alphanumeric, Applicable},
+		"Blank2":                   {2, 117, Alphanumeric, Nullable},
+		"SpecialDataEntries":       {119, 60, Alphanumeric, Applicable},
+		"Blank3":                   {179, 26, Alphanumeric, Nullable},
+		"Blank4":                   {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1098-F
+	Sub1098FLayout = map[string]SpecField{
+		"Blank1":       {0, 3, Alphanumeric, Nullable},
+		"DateOfOrder":  {3, 8, Date, Required},
+		"Jurisdiction": {11, 39, Alphanumeric, Required},
+		"CaseNumber":   {50, 40, Alphanumeric, Applicable},
+		"CaseName":     {90, 39, Alphanumeric, Applicable},
+		"PaymentCode":  {129, 8, Alphanumeric, Applicable},
+		"Blank2":       {137, 68, Alphanumeric, Nullable},
+		"Blank3":       {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1098-Q
+	Sub1098QLayout = map[string]SpecField{
+		"Blank1":                    {0, 3, Alphanumeric, Nullable},
+		"AnnuityStartDate":          {3, 8, Date, Required},
+		"StartDateMayBeAccelerated": {11, 1, Alphanumeric, Applicable},
+		"DateOfBirth":               {12, 8, Date, Required},
+		"PlanName":                  {20, 40, Alphanumeric, Required},
+		"PlanNumber":                {60, 3, Numeric, Applicable},
+		"PlanSponsorEIN":            {63, 9, Numeric, Applicable},
+		"Blank2":                    {72, 47, Alphanumeric, Nullable},
+		"SpecialDataEntries":        {119, 60, Alphanumeric, Applicable},
+		"Blank3":                    {179, 26, Alphanumeric, Nullable},
+		"Blank4":                    {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1098-T
+	Sub1098TLayout = map[string]SpecField{
+		"Blank1":                   {0, 3, Alphanumeric, Nullable},
+		"HalfTimeStudentIndicator": {3, 1, Alphanumeric, Applicable},
+		"GraduateStudentIndicator": {4, 1, Alphanumeric, Applicable},
+		"AcademicPeriodIndicator":  {5, 1, Alphanumeric, Applicable},
+		"Blank2":                   {6, 113, Alphanumeric, Nullable},
+		"SpecialDataEntries":       {119, 60, Alphanumeric, Applicable},
+		"Blank3":                   {179, 26, Alphanumeric, Nullable},
+		"Blank4":                   {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1099-A
+	Sub1099ALayout = map[string]SpecField{
+		"Blank1":                     {0, 3, Alphanumeric, Nullable},
+		"PersonalLiabilityIndicator": {3, 1, Alphanumeric, Applicable},
+		"DateLenderAcquisition":      {4, 8, Date, Required},
+		"PropertyDescription":        {12, 39, Alphanumeric, Applicable},
+		"Blank2":                     {51, 68, Alphanumeric, Nullable},
+		"SpecialDataEntries":         {119, 60, Alphanumeric, Applicable},
+		"Blank3":                     {179, 26, Alphanumeric, Nullable},
+		"Blank4":                     {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1099-B
+	Sub1099BLayout = map[string]SpecField{
+		"SecondTinNotice":             {0, 1, Alphanumeric, Applicable},
+		"NoncoveredSecurityIndicator": {1, 1, Alphanumeric, Applicable},
+		"TypeOfGainLossIndicator":     {2, 1, Alphanumeric, Applicable},
+		"GrossProceedsIndicator":      {3, 1, Alphanumeric, Applicable},
+		"DateSold":                    {4, 8, Date, Applicable},
+		"CUSIP":                       {12, 13, AlphanumericRightAlign, Applicable},
+		"Description":                 {25, 39, Alphanumeric, Applicable},
+		"DateAcquired":                {64, 8, Date, Applicable},
+		"LossNotAllowedIndicator":     {72, 1, Alphanumeric, Applicable},
+		"ReportedToIRS":               {73, 1, Alphanumeric, Applicable},
+		"CollectiblesIndicator":       {74, 1, Alphanumeric, Applicable},
+		"FATCA":                       {75, 1, Alphanumeric, Applicable},
+		"QOFIndicator":                {76, 1, Alphanumeric, Applicable},
+		"Blank1":                      {77, 42, Alphanumeric, Nullable},
+		"SpecialDataEntries":          {119, 60, Alphanumeric, Applicable},
+		"StateIncomeTaxWithheld":      {179, 12, ZeroNumeric, Applicable},
+		"LocalIncomeTaxWithheld":      {191, 12, ZeroNumeric, Applicable},
+		"CombinedFSCode":              {203, 2, Numeric, Applicable},
+		"Blank2":                      {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1099-C
+	Sub1099CLayout = map[string]SpecField{
+		"Blank1":                     {0, 3, Alphanumeric, Nullable},
+		"IdentifiableEventCode":      {3, 1, Alphanumeric, Required},
+		"DateCanceled":               {4, 8, Date, Required},
+		"DebtDescription":            {12, 39, Alphanumeric, Applicable},
+		"PersonalLiabilityIndicator": {51, 1, Alphanumeric, Applicable},
+		"Blank2":                     {52, 67, Alphanumeric, Nullable},
+		"SpecialDataEntries":         {119, 60, Alphanumeric, Applicable},
+		"Blank3":                     {179, 26, Alphanumeric, Nullable},
+		"Blank4":                     {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1099-DIV
+	Sub1099DIVLayout = map[string]SpecField{
+		"SecondTinNotice":        {0, 1, Alphanumeric, Applicable},
+		"Blank1":                 {1, 2, Alphanumeric, Nullable},
+		"ForeignCountry":         {3, 40, Alphanumeric, Applicable},
+		"FATCA":                  {43, 1, Alphanumeric, Applicable},
+		"Blank2":                 {44, 75, Alphanumeric, Nullable},
+		"SpecialDataEntries":     {119, 60, Alphanumeric, Applicable},
+		"StateIncomeTaxWithheld": {179, 12, ZeroNumeric, Applicable},
+		"LocalIncomeTaxWithheld": {191, 12, ZeroNumeric, Applicable},
+		"CombinedFSCode":         {203, 2, Numeric, Applicable},
+		"Blank3":                 {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1099-G
+	Sub1099GLayout = map[string]SpecField{
+		"SecondTinNotice":          {0, 1, Alphanumeric, Applicable},
+		"Blank1":                   {1, 2, Alphanumeric, Nullable},
+		"TradeOrBusinessIndicator": {3, 1, Alphanumeric, Applicable},
+		"TaxYearOfRefund":          {4, 4, Numeric, Applicable},
+		"Blank2":                   {8, 111, Alphanumeric, Nullable},
+		"SpecialDataEntries":       {119, 60, Alphanumeric, Applicable},
+		"StateIncomeTaxWithheld":   {179, 12, ZeroNumeric, Applicable},
+		"LocalIncomeTaxWithheld":   {191, 12, ZeroNumeric, Applicable},
+		"CombinedFSCode":           {203, 2, Numeric, Applicable},
+		"Blank3":                   {205, 2, Alphanumeric, Nullable},
+	}
 	// Record Layout Positions 544-750 for Form 1099-INT
 	Sub1099INTLayout = map[string]SpecField{
 		"SecondTinNotice":        {0, 1, Alphanumeric, Applicable},
@@ -237,9 +389,45 @@ var (
 		"SpecialDataEntries":     {119, 60, Alphanumeric, Applicable},
 		"StateIncomeTaxWithheld": {179, 12, ZeroNumeric, Applicable},
 		"LocalIncomeTaxWithheld": {191, 12, ZeroNumeric, Applicable},
-		"CombinedFSCode":         {203, 2, ZeroNumeric, Required},
+		"CombinedFSCode":         {203, 2, Numeric, Applicable},
 		"Blank3":                 {205, 2, Alphanumeric, Nullable},
 	}
+	// Record Layout Positions 544-750 for Form 1099-K
+	Sub1099KLayout = map[string]SpecField{
+		"SecondTinNotice":        {0, 1, Alphanumeric, Applicable},
+		"Blank1":                 {1, 2, Alphanumeric, Nullable},
+		"FilerType":              {3, 1, Alphanumeric, Required},
+		"PaymentIndicator":       {4, 1, Alphanumeric, Required},
+		"NumberTransactions":     {5, 13, ZeroNumeric, Required},
+		"Blank2":                 {18, 3, Alphanumeric, Nullable},
+		"PSENamePhone":           {21, 40, Alphanumeric, Applicable},
+		"MerchantCategoryCode":   {61, 4, Numeric, Applicable},
+		"Blank3":                 {65, 54, Alphanumeric, Nullable},
+		"SpecialDataEntries":     {119, 60, Alphanumeric, Applicable},
+		"StateIncomeTaxWithheld": {179, 12, ZeroNumeric, Applicable},
+		"LocalIncomeTaxWithheld": {191, 12, ZeroNumeric, Applicable},
+		"CombinedFSCode":         {203, 2, Numeric, Applicable},
+		"Blank4":                 {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1099-LTC
+	Sub1099LTCLayout = map[string]SpecField{
+		"Blank1":                     {0, 3, Alphanumeric, Nullable},
+		"TypeOfPayment":              {3, 1, Alphanumeric, Applicable},
+		"SSNInsured":                 {4, 9, Numeric, Required},
+		"NameInsured":                {13, 40, Alphanumeric, Required},
+		"AddressInsured":             {53, 40, Alphanumeric, Required},
+		"CityInsured":                {93, 40, Alphanumeric, Required},
+		"StateInsured":               {133, 2, Alphanumeric, Required},
+		"ZipCodeInsured":             {135, 9, Numeric, Required},
+		"StatusOfIllness":            {144, 1, Alphanumeric, Applicable},
+		"DateCertified":              {145, 8, Date, Applicable},
+		"QualifiedContractIndicator": {153, 1, Alphanumeric, Applicable},
+		"Blank2":                     {154, 25, Alphanumeric, Nullable},
+		"StateIncomeTaxWithheld":     {179, 12, ZeroNumeric, Applicable},
+		"LocalIncomeTaxWithheld":     {191, 12, ZeroNumeric, Applicable},
+		"Blank3":                     {203, 2, Alphanumeric, Nullable},
+		"Blank4":                     {205, 2, Alphanumeric, Nullable},
+	}
 	// Record Layout Positions 544-750 for Form 1099-MISC
 	Sub1099MISCLayout = map[string]SpecField{
 		"SecondTinNotice":        {0, 1, Alphanumeric, Applicable},
@@ -250,7 +438,20 @@ var (
 		"SpecialDataEntries":     {119, 60, Alphanumeric, Applicable},
 		"StateIncomeTaxWithheld": {179, 12, ZeroNumeric, Applicable},
 		"LocalIncomeTaxWithheld": {191, 12, ZeroNumeric, Applicable},
-		"CombinedFSCode":         {203, 2, ZeroNumeric, Required},
+		"CombinedFSCode":         {203, 2, Numeric, Applicable},
+		"Blank3":                 {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1099-NEC
+	Sub1099NECLayout = map[string]SpecField{
+		"SecondTinNotice":        {0, 1, Alphanumeric, Applicable},
+		"Blank1":                 {1, 2, Alphanumeric, Nullable},
+		"DirectSalesIndicator":   {3, 1, Alphanumeric, Applicable},
+		"FATCA":                  {4, 1, Alphanumeric, Applicable},
+		"Blank2":                 {5, 114, Alphanumeric, Nullable},
+		"SpecialDataEntries":     {119, 60, Alphanumeric, Applicable},
+		"StateIncomeTaxWithheld": {179, 12, ZeroNumeric, Applicable},
+		"LocalIncomeTaxWithheld": {191, 12, ZeroNumeric, Applicable},
+		"CombinedFSCode":         {203, 2, Numeric, Applicable},
 		"Blank3":                 {205, 2, Alphanumeric, Nullable},
 	}
 	// Record Layout Positions 544-750 for Form 1099-OID
@@ -263,7 +464,7 @@ var (
 		"SpecialDataEntries":     {119, 60, Alphanumeric, Applicable},
 		"StateIncomeTaxWithheld": {179, 12, ZeroNumeric, Applicable},
 		"LocalIncomeTaxWithheld": {191, 12, ZeroNumeric, Applicable},
-		"CombinedFSCode":         {203, 2, ZeroNumeric, Required},
+		"CombinedFSCode":         {203, 2, Numeric, Applicable},
 		"Blank3":                 {205, 2, Alphanumeric, Nullable},
 	}
 	// Record Layout Positions 544-750 for Form 1099-PATR
@@ -273,11 +474,186 @@ var (
 		"SpecialDataEntries":     {119, 60, Alphanumeric, Applicable},
 		"StateIncomeTaxWithheld": {179, 12, ZeroNumeric, Applicable},
 		"LocalIncomeTaxWithheld": {191, 12, ZeroNumeric, Applicable},
-		"CombinedFSCode":         {203, 2, ZeroNumeric, Required},
+		"CombinedFSCode":         {203, 2, Numeric, Applicable},
 		"Blank3":                 {205, 2, Alphanumeric, Nullable},
 	}
+	// Record Layout Positions 544-750 for Form 1099-Q
+	Sub1099QLayout = map[string]SpecField{
+		"Blank1":                         {0, 3, Alphanumeric, Nullable},
+		"TrusteeToTrusteeIndicator":      {3, 1, Alphanumeric, Applicable},
+		"TypeOfTuitionPayment":           {4, 1, Alphanumeric, Applicable},
+		"DesignatedBeneficiaryIndicator": {5, 1, Alphanumeric, Applicable},
+		"Blank2":                         {6, 113, Alphanumeric, Nullable},
+		"SpecialDataEntries":             {119, 60, Alphanumeric, Applicable},
+		"Blank3":                         {179, 26, Alphanumeric, Nullable},
+		"Blank4":                         {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1099-R
+	Sub1099RLayout = map[string]SpecField{
+		"SecondTinNotice":               {0, 1, Alphanumeric, Applicable},
+		"DistributionCode":              {1, 2, Alphanumeric, Required},
+		"TaxableAmountNotDetermined":    {3, 1, Alphanumeric, Applicable},
+		"IRASEPSIMPLEIndicator":         {4, 1, Alphanumeric, Applicable},
+		"TotalDistributionIndicator":    {5, 1, Alphanumeric, Applicable},
+		"PercentageOfTotalDistribution": {6, 2, Numeric, Applicable},
+		"FirstYearDesignatedRoth":       {8, 4, Numeric, Applicable},
+		"FATCA":                         {12, 1, Alphanumeric, Applicable},
+		"DateOfPayment":                 {13, 8, Date, Applicable},
+		"Blank1":                        {21, 98, Alphanumeric, Nullable},
+		"SpecialDataEntries":            {119, 60, Alphanumeric, Applicable},
+		"StateIncomeTaxWithheld":        {179, 12, ZeroNumeric, Applicable},
+		"LocalIncomeTaxWithheld":        {191, 12, ZeroNumeric, Applicable},
+		"CombinedFSCode":                {203, 2, Numeric, Applicable},
+		"Blank2":                        {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1099-S
+	Sub1099SLayout = map[string]SpecField{
+		"Blank1":                      {0, 3, Alphanumeric, Nullable},
+		"DateOfClosing":               {3, 8, Date, Required},
+		"PropertyAddress":             {11, 39, Alphanumeric, Required},
+		"PropertyOrServicesIndicator": {50, 1, Alphanumeric, Applicable},
+		"ForeignTransferorIndicator":  {51, 1, Alphanumeric, Applicable},
+		"Blank2":                      {52, 67, Alphanumeric, Nullable},
+		"SpecialDataEntries":          {119, 60, Alphanumeric, Applicable},
+		"Blank3":                      {179, 26, Alphanumeric, Nullable},
+		"Blank4":                      {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 1099-SA
+	Sub1099SALayout = map[string]SpecField{
+		"Blank1":                        {0, 3, Alphanumeric, Nullable},
+		"DistributionCode":              {3, 1, Alphanumeric, Required},
+		"Blank2":                        {4, 1, Alphanumeric, Nullable},
+		"MedicareAdvantageMSAIndicator": {5, 1, Alphanumeric, Applicable},
+		"HSAIndicator":                  {6, 1, Alphanumeric, Applicable},
+		"ArcherMSAIndicator":            {7, 1, Alphanumeric, Applicable},
+		"Blank3":                        {8, 111, Alphanumeric, Nullable},
+		"SpecialDataEntries":            {119, 60, Alphanumeric, Applicable},
+		"Blank4":                        {179, 26, Alphanumeric, Nullable},
+		"Blank5":                        {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 3921
+	Sub3921Layout = map[string]SpecField{
+		"Blank1":              {0, 3, Alphanumeric, Nullable},
+		"DateOptionGranted":   {3, 8, Date, Required},
+		"DateOptionExercised": {11, 8, Date, Required},
+		"NumberShares":        {19, 8, ZeroNumeric, Required},
+		"OtherName":           {27, 40, Alphanumeric, Applicable},
+		"OtherAddress":        {67, 40, Alphanumeric, Applicable},
+		"OtherCity":           {107, 40, Alphanumeric, Applicable},
+		"OtherState":          {147, 2, Alphanumeric, Applicable},
+		"OtherZipCode":        {149, 9, Numeric, Applicable},
+		"Blank2":              {158, 47, Alphanumeric, Nullable},
+		"Blank3":              {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 3922
+	Sub3922Layout = map[string]SpecField{
+		"Blank1":                    {0, 3, Alphanumeric, Nullable},
+		"DateOptionGranted":         {3, 8, Date, Required},
+		"DateOptionExercised":       {11, 8, Date, Required},
+		"NumberShares":              {19, 8, ZeroNumeric, Required},
+		"DateLegalTitleTransferred": {27, 8, Date, Applicable},
+		"Blank2":                    {35, 170, Alphanumeric, Nullable},
+		"Blank3":                    {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 5498
+	Sub5498Layout = map[string]SpecField{
+		"Blank1":                      {0, 3, Alphanumeric, Nullable},
+		"IRAIndicator":                {3, 1, Alphanumeric, Applicable},
+		"SEPIndicator":                {4, 1, Alphanumeric, Applicable},
+		"SIMPLEIndicator":             {5, 1, Alphanumeric, Applicable},
+		"RothIRAIndicator":            {6, 1, Alphanumeric, Applicable},
+		"RMDIndicator":                {7, 1, Alphanumeric, Applicable},
+		"YearPostponedContribution":   {8, 4, Numeric, Applicable},
+		"PostponedContributionCode":   {12, 2, Alphanumeric, Applicable},
+		"PostponedContributionReason": {14, 6, Alphanumeric, Applicable},
+		"RepaymentCode":               {20, 2, Alphanumeric, Applicable},
+		"RMDDate":                     {22, 8, Date, Applicable},
+		"Blank2":                      {30, 89, Alphanumeric, Nullable},
+		"SpecialDataEntries":          {119, 60, Alphanumeric, Applicable},
+		"Blank3":                      {179, 26, Alphanumeric, Nullable},
+		"Blank4":                      {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 5498-ESA
+	Sub5498ESALayout = map[string]SpecField{
+		"Blank1":             {0, 119, Alphanumeric, Nullable},
+		"SpecialDataEntries": {119, 60, Alphanumeric, Applicable},
+		"Blank2":             {179, 26, Alphanumeric, Nullable},
+		"Blank3":             {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form 5498-SA
+	Sub5498SALayout = map[string]SpecField{
+		"Blank1":                        {0, 3, Alphanumeric, Nullable},
+		"MedicareAdvantageMSAIndicator": {3, 1, Alphanumeric, Applicable},
+		"HSAIndicator":                  {4, 1, Alphanumeric, Applicable},
+		"ArcherMSAIndicator":            {5, 1, Alphanumeric, Applicable},
+		"Blank2":                        {6, 113, Alphanumeric, Nullable},
+		"SpecialDataEntries":            {119, 60, Alphanumeric, Applicable},
+		"Blank3":                        {179, 26, Alphanumeric, Nullable},
+		"Blank4":                        {205, 2, Alphanumeric, Nullable},
+	}
+	// Record Layout Positions 544-750 for Form W-2G
+	SubW2GLayout = map[string]SpecField{
+		"Blank1":                 {0, 3, Alphanumeric, Nullable},
+		"TypeOfWager":            {3, 1, Alphanumeric, Required},
+		"DateWon":                {4, 8, Date, Required},
+		"Transaction":            {12, 15, Alphanumeric, Applicable},
+		"Race":                   {27, 5, Alphanumeric, Applicable},
+		"Cashier":                {32, 5, Alphanumeric, Applicable},
+		"Window":                 {37, 5, Alphanumeric, Applicable},
+		"FirstID":                {42, 15, Alphanumeric, Applicable},
+		"SecondID":               {57, 15, Alphanumeric, Applicable},
+		"Blank2":                 {72, 47, Alphanumeric, Nullable},
+		"SpecialDataEntries":     {119, 60, Alphanumeric, Applicable},
+		"StateIncomeTaxWithheld": {179, 12, ZeroNumeric, Applicable},
+		"LocalIncomeTaxWithheld": {191, 12, ZeroNumeric, Applicable},
+		"Blank3":                 {203, 2, Alphanumeric, Nullable},
+		"Blank4":                 {205, 2, Alphanumeric, Nullable},
+	}
 )
 
+// Layouts of general records, keyed by record type
+var RecordLayouts = map[string]map[string]SpecField{
+	TRecordType: TRecordLayout,
+	ARecordType: ARecordLayout,
+	BRecordType: BRecordLayout,
+	CRecordType: CRecordLayout,
+	KRecordType: KRecordLayout,
+	FRecordType: FRecordLayout,
+}
+
+// Layouts of extension blocks of payee “B” records, keyed by type of return
+var SubRecordLayouts = map[string]map[string]SpecField{
+	Sub1097BtcType:  Sub1097BTCLayout,
+	Sub1098Type:     Sub1098Layout,
+	Sub1098CType:    Sub1098CLayout,
+	Sub1098EType:    Sub1098ELayout,
+	Sub1098FType:    Sub1098FLayout,
+	Sub1098QType:    Sub1098QLayout,
+	Sub1098TType:    Sub1098TLayout,
+	Sub1099AType:    Sub1099ALayout,
+	Sub1099BType:    Sub1099BLayout,
+	Sub1099CType:    Sub1099CLayout,
+	Sub1099DivType:  Sub1099DIVLayout,
+	Sub1099GType:    Sub1099GLayout,
+	Sub1099IntType:  Sub1099INTLayout,
+	Sub1099KType:    Sub1099KLayout,
+	Sub1099LtcType:  Sub1099LTCLayout,
+	Sub1099MiscType: Sub1099MISCLayout,
+	Sub1099NecType:  Sub1099NECLayout,
+	Sub1099OidType:  Sub1099OIDLayout,
+	Sub1099PatrType: Sub1099PATRLayout,
+	Sub1099QType:    Sub1099QLayout,
+	Sub1099RType:    Sub1099RLayout,
+	Sub1099SType:    Sub1099SLayout,
+	Sub1099SaType:   Sub1099SALayout,
+	Sub3921Type:     Sub3921Layout,
+	Sub3922Type:     Sub3922Layout,
+	Sub5498Type:     Sub5498Layout,
+	Sub5498EsaType:  Sub5498ESALayout,
+	Sub5498SaType:   Sub5498SALayout,
+	SubW2GType:      SubW2GLayout,
+}
+
 func ToSpecifications(fieldsFormat map[string]SpecField) []SpecRecord {
 	var records []SpecRecord
 	for key, field := range fieldsFormat {
@@ -5,6 +5,8 @@ const (
 	FormatAscii = "ascii"
 	// Json Format (Variable blocked)
 	FormatJson = "json"
+	// Csv Format (rows of payee “B” records)
+	FormatCsv = "csv"
 	// maximum number of “A” Records
 	MaximumTransmitterRecord = 99000
 )
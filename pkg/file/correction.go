@@ -0,0 +1,196 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+// Correction identifies corrected data of a payee “B” record of a previously filed file
+type Correction struct {
+	// Record sequence number of the payee “B” record in the previously filed file
+	SequenceNumber int `json:"sequence_number"`
+
+	// Payee “B” record with all correct information of the payee, including information that didn't change,
+	// as a JSON object of fields of the record or a JSON string of the fire ascii record.
+	// The record is decoded with the extension block of the type of return of the filed payer.
+	Payee json.RawMessage `json:"payee"`
+}
+
+// correctedPerson collects corrected payee “B” records of a payer of the filed file
+type correctedPerson struct {
+	payer        records.Record
+	oneCoded     []records.Record
+	twoCoded     []records.Record
+	typeOfReturn string
+}
+
+// CreateCorrectionFile builds a correction file from a previously filed file and corrected payee data.
+//
+// Payees with incorrect money amounts, codes, check boxes or address are corrected by one transaction,
+// a “G” coded record with the corrected data. Payees with incorrect TIN or name are corrected by two transactions,
+// a “G” coded record identical to the filed record with all payment amounts and state withholding zero, followed by a “C” coded record
+// with the corrected data. “G” and “C” coded records are reported using separate copies of the payer “A” record,
+// and end of payer “C”, state totals “K” and end of transmission “F” records are generated with recomputed totals.
+//
+// The transmitter “T” record is copied from the filed file as a production file, without the test file indicator.
+// Its prior year data indicator is set from the payment year when the tax year of the filing season is given by
+// SetValidation of the filed file, otherwise the indicator of the filed file is kept and should be reviewed by the caller,
+// since corrections are often filed after the filing season of the payment year.
+func CreateCorrectionFile(filed File, corrections []Correction) (File, error) {
+	instance, ok := filed.(*fileInstance)
+	if !ok || instance.Transmitter == nil || instance.EndTransmitter == nil {
+		return nil, utils.ErrInvalidFile
+	}
+
+	type filedPayee struct {
+		person *paymentPerson
+		payee  *records.BRecord
+	}
+	payees := make(map[int]filedPayee)
+	for _, person := range instance.PaymentPersons {
+		for _, record := range person.Payees {
+			if payee, ok := record.(*records.BRecord); ok {
+				payees[payee.SequenceNumber()] = filedPayee{person, payee}
+			}
+		}
+	}
+
+	corrected := make(map[*paymentPerson]*correctedPerson)
+	for _, correction := range corrections {
+		original, ok := payees[correction.SequenceNumber]
+		if !ok || len(correction.Payee) == 0 {
+			return nil, utils.NewErrUnknownPayee(correction.SequenceNumber)
+		}
+
+		person, ok := corrected[original.person]
+		if !ok {
+			person = &correctedPerson{payer: original.person.Payer, typeOfReturn: original.payee.TypeOfReturn()}
+			corrected[original.person] = person
+		}
+
+		payee, err := decodePayee(correction.Payee, person.typeOfReturn)
+		if err != nil {
+			return nil, fmt.Errorf("payee of sequence number %d: %w", correction.SequenceNumber, err)
+		}
+		if !isTwoTransactionCorrection(original.payee, payee) {
+			payee.CorrectedReturnIndicator = config.CorrectedReturnIndicatorG
+			person.oneCoded = append(person.oneCoded, payee)
+			continue
+		}
+
+		first, err := copyPayee(original.payee, person.typeOfReturn)
+		if err != nil {
+			return nil, err
+		}
+		first.ClearPaymentAmounts()
+		first.ClearStateReporting()
+		first.CorrectedReturnIndicator = config.CorrectedReturnIndicatorG
+		person.oneCoded = append(person.oneCoded, first)
+
+		payee.CorrectedReturnIndicator = config.CorrectedReturnIndicatorC
+		person.twoCoded = append(person.twoCoded, payee)
+	}
+
+	f := &fileInstance{
+		Transmitter:    records.NewTRecord(),
+		EndTransmitter: records.NewFRecord(),
+		validateOpts:   instance.validateOpts,
+	}
+	if err := f.Transmitter.Parse(instance.Transmitter.Ascii()); err != nil {
+		return nil, err
+	}
+	setCorrectionIndicators(f.Transmitter.(*records.TRecord), instance.validateOpts)
+	if err := f.EndTransmitter.Parse(instance.EndTransmitter.Ascii()); err != nil {
+		return nil, err
+	}
+
+	// keep payers in order of the filed file, with “G” coded records before “C” coded records
+	for _, filedPerson := range instance.PaymentPersons {
+		person, ok := corrected[filedPerson]
+		if !ok {
+			continue
+		}
+		for _, payees := range [][]records.Record{person.oneCoded, person.twoCoded} {
+			if len(payees) == 0 {
+				continue
+			}
+			payer := records.NewARecord()
+			if err := payer.Parse(person.payer.Ascii()); err != nil {
+				return nil, err
+			}
+			f.PaymentPersons = append(f.PaymentPersons, &paymentPerson{Payer: payer, Payees: payees})
+		}
+	}
+
+	if err := f.GenerateEndPayers(); err != nil {
+		return nil, err
+	}
+	// records are renumbered by generating state totals
+	if err := f.GenerateStates(); err != nil {
+		return nil, err
+	}
+	if err := f.GenerateEndTransmitter(); err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+// setCorrectionIndicators sets test file and prior year data indicators of transmitter “T” record of a correction file
+func setCorrectionIndicators(transmitter *records.TRecord, opts *ValidateOpts) {
+	transmitter.TestFileIndicator = ""
+	if opts == nil || opts.TaxYear == 0 || transmitter.PaymentYear == 0 {
+		return
+	}
+	if transmitter.PaymentYear < opts.TaxYear {
+		transmitter.PriorYearDataIndicator = config.PriorYearDataIndicator
+	} else {
+		transmitter.PriorYearDataIndicator = ""
+	}
+}
+
+// copyPayee returns a copy of payee “B” record with the extension block of the type of return
+func copyPayee(payee *records.BRecord, typeOfReturn string) (*records.BRecord, error) {
+	if payee.TypeOfReturn() != typeOfReturn {
+		return nil, fmt.Errorf("unexpected payee of type of return %s, but got %s", typeOfReturn, payee.TypeOfReturn())
+	}
+	copied := records.NewBRecord(typeOfReturn).(*records.BRecord)
+	if err := copied.Parse(payee.Ascii()); err != nil {
+		return nil, err
+	}
+	return copied, nil
+}
+
+// decodePayee returns payee “B” record with the extension block of the type of return
+// from a JSON object of fields of the record or a JSON string of the fire ascii record
+func decodePayee(data json.RawMessage, typeOfReturn string) (*records.BRecord, error) {
+	payee := records.NewBRecord(typeOfReturn).(*records.BRecord)
+	var ascii string
+	if err := json.Unmarshal(data, &ascii); err == nil {
+		if err := payee.Parse([]byte(ascii)); err != nil {
+			return nil, err
+		}
+		return payee, nil
+	}
+	if err := json.Unmarshal(data, payee); err != nil {
+		return nil, err
+	}
+	return payee, nil
+}
+
+// isTwoTransactionCorrection returns true if the correction of the payee requires two transactions,
+// that is when TIN or name of the payee is incorrect
+func isTwoTransactionCorrection(filed, corrected *records.BRecord) bool {
+	return filed.TIN != corrected.TIN ||
+		filed.NameControl != corrected.NameControl ||
+		filed.FirstPayeeNameLine != corrected.FirstPayeeNameLine ||
+		filed.SecondPayeeNameLine != corrected.SecondPayeeNameLine
+}
@@ -0,0 +1,166 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package file
+
+import (
+	"encoding/json"
+	"errors"
+
+	"gopkg.in/check.v1"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+func (t *FileTest) TestCreateCorrectionFile(c *check.C) {
+	filed, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := filed.(*fileInstance)
+
+	// incorrect money amount
+	first, err := copyPayee(instance.PaymentPersons[0].Payees[0].(*records.BRecord), config.Sub1099MiscType)
+	c.Assert(err, check.IsNil)
+	first.PaymentAmount1 = 150
+	// incorrect payee TIN
+	second, err := copyPayee(instance.PaymentPersons[0].Payees[1].(*records.BRecord), config.Sub1099MiscType)
+	c.Assert(err, check.IsNil)
+	second.TIN = "123123123"
+
+	firstJson, err := json.Marshal(first)
+	c.Assert(err, check.IsNil)
+	f, err := CreateCorrectionFile(filed, []Correction{
+		{SequenceNumber: 3, Payee: firstJson},
+		{SequenceNumber: 4, Payee: asciiPayee(c, second)},
+	})
+	c.Assert(err, check.IsNil)
+	c.Assert(f.Validate(), check.IsNil)
+
+	corrected := f.(*fileInstance)
+	c.Assert(corrected.PaymentPersons, check.HasLen, 2)
+	gCoded := corrected.PaymentPersons[0]
+	c.Assert(gCoded.Payees, check.HasLen, 2)
+	c.Assert(gCoded.Payees[0].(*records.BRecord).CorrectedReturnIndicator, check.Equals, config.CorrectedReturnIndicatorG)
+	c.Assert(gCoded.Payees[0].(*records.BRecord).PaymentAmount1, check.Equals, 150)
+	zeroed := gCoded.Payees[1].(*records.BRecord)
+	c.Assert(zeroed.CorrectedReturnIndicator, check.Equals, config.CorrectedReturnIndicatorG)
+	c.Assert(zeroed.TIN, check.Equals, instance.PaymentPersons[0].Payees[1].(*records.BRecord).TIN)
+	c.Assert(zeroed.PaymentAmount2, check.Equals, 0)
+	c.Assert(zeroed.CombinedFSCode(), check.Equals, 0)
+	c.Assert(zeroed.StateIncomeTaxWithheld(), check.Equals, 0)
+	c.Assert(zeroed.LocalIncomeTaxWithheld(), check.Equals, 0)
+	c.Assert(gCoded.EndPayer.(*records.CRecord).ControlTotal1, check.Equals, 150)
+	c.Assert(gCoded.EndPayer.(*records.CRecord).NumberPayees, check.Equals, 2)
+	// the zeroed record isn't counted in state totals of the CF/SF coded payees
+	c.Assert(gCoded.States, check.HasLen, 1)
+	gState := gCoded.States[0].(*records.KRecord)
+	c.Assert(gState.CombinedFederalStateCode, check.Equals, 1)
+	c.Assert(gState.NumberPayees, check.Equals, 1)
+	c.Assert(gState.StateIncomeTaxWithheldTotal, check.Equals, 4)
+	c.Assert(gState.LocalIncomeTaxWithheldTotal, check.Equals, 2)
+
+	cCoded := corrected.PaymentPersons[1]
+	c.Assert(cCoded.Payees, check.HasLen, 1)
+	c.Assert(cCoded.Payees[0].(*records.BRecord).CorrectedReturnIndicator, check.Equals, config.CorrectedReturnIndicatorC)
+	c.Assert(cCoded.Payees[0].(*records.BRecord).TIN, check.Equals, "123123123")
+	c.Assert(cCoded.EndPayer.(*records.CRecord).ControlTotal2, check.Equals, 200)
+	c.Assert(cCoded.States, check.HasLen, 1)
+	cState := cCoded.States[0].(*records.KRecord)
+	c.Assert(cState.NumberPayees, check.Equals, 1)
+	c.Assert(cState.StateIncomeTaxWithheldTotal, check.Equals, 0)
+	c.Assert(cState.LocalIncomeTaxWithheldTotal, check.Equals, 1)
+
+	endTransmitter := corrected.EndTransmitter.(*records.FRecord)
+	c.Assert(endTransmitter.NumberPayerRecords, check.Equals, 2)
+	c.Assert(endTransmitter.TotalNumberPayees, check.Equals, 3)
+	c.Assert(endTransmitter.RecordSequenceNumber, check.Equals, 11)
+
+	// the filed file is unchanged
+	c.Assert(string(filed.Ascii()), check.Equals, string(t.oneTransactionAscii))
+
+	_, err = CreateCorrectionFile(filed, []Correction{{SequenceNumber: 5, Payee: firstJson}})
+	c.Assert(utils.ErrorCode(err), check.Equals, utils.CodeUnknownPayee)
+}
+
+func (t *FileTest) TestCreateCorrectionFileFromJson(c *check.C) {
+	filed, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := filed.(*fileInstance)
+
+	first, err := copyPayee(instance.PaymentPersons[0].Payees[0].(*records.BRecord), config.Sub1099MiscType)
+	c.Assert(err, check.IsNil)
+	first.PaymentAmount1 = 150
+	payee, err := json.Marshal(first)
+	c.Assert(err, check.IsNil)
+
+	buf, err := json.Marshal([]Correction{{SequenceNumber: 3, Payee: payee}})
+	c.Assert(err, check.IsNil)
+	var corrections []Correction
+	c.Assert(json.Unmarshal(buf, &corrections), check.IsNil)
+
+	f, err := CreateCorrectionFile(filed, corrections)
+	c.Assert(err, check.IsNil)
+	c.Assert(f.Validate(), check.IsNil)
+	corrected := f.(*fileInstance).PaymentPersons[0].Payees[0].(*records.BRecord)
+	c.Assert(corrected.PaymentAmount1, check.Equals, 150)
+	// fields of the extension block are kept
+	c.Assert(corrected.CombinedFSCode(), check.Equals, first.CombinedFSCode())
+	c.Assert(corrected.StateIncomeTaxWithheld(), check.Equals, first.StateIncomeTaxWithheld())
+	c.Assert(string(corrected.Ascii()[6:]), check.Equals, string(first.Ascii()[6:]))
+
+	_, err = CreateCorrectionFile(filed, []Correction{{SequenceNumber: 3, Payee: json.RawMessage(`"B"`)}})
+	c.Assert(errors.Is(err, utils.ErrRecordLength), check.Equals, true)
+}
+
+func (t *FileTest) TestCreateCorrectionFileIndicators(c *check.C) {
+	filed, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := filed.(*fileInstance)
+	transmitter := instance.Transmitter.(*records.TRecord)
+	transmitter.TestFileIndicator = config.TestFileIndicator
+	payee, err := json.Marshal(instance.PaymentPersons[0].Payees[0])
+	c.Assert(err, check.IsNil)
+	corrections := []Correction{{SequenceNumber: 3, Payee: payee}}
+
+	// without the tax year, the prior year data indicator of the filed file is kept
+	f, err := CreateCorrectionFile(filed, corrections)
+	c.Assert(err, check.IsNil)
+	corrected := f.(*fileInstance).Transmitter.(*records.TRecord)
+	c.Assert(corrected.TestFileIndicator, check.Equals, "")
+	c.Assert(corrected.PriorYearDataIndicator, check.Equals, config.PriorYearDataIndicator)
+
+	filed.SetValidation(&ValidateOpts{TaxYear: transmitter.PaymentYear})
+	f, err = CreateCorrectionFile(filed, corrections)
+	c.Assert(err, check.IsNil)
+	c.Assert(f.Validate(), check.IsNil)
+	c.Assert(f.(*fileInstance).Transmitter.(*records.TRecord).PriorYearDataIndicator, check.Equals, "")
+
+	transmitter.PriorYearDataIndicator = ""
+	filed.SetValidation(&ValidateOpts{TaxYear: transmitter.PaymentYear + 1})
+	f, err = CreateCorrectionFile(filed, corrections)
+	c.Assert(err, check.IsNil)
+	c.Assert(f.Validate(), check.IsNil)
+	c.Assert(f.(*fileInstance).Transmitter.(*records.TRecord).PriorYearDataIndicator, check.Equals, config.PriorYearDataIndicator)
+}
+
+// asciiPayee returns fire ascii of payee “B” record as a JSON string
+func asciiPayee(c *check.C, payee *records.BRecord) json.RawMessage {
+	buf, err := json.Marshal(string(payee.Ascii()))
+	c.Assert(err, check.IsNil)
+	return buf
+}
+
+func (t *FileTest) TestValidateCorrectedReturns(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	payee := f.(*fileInstance).PaymentPersons[0].Payees[1].(*records.BRecord)
+	payee.CorrectedReturnIndicator = config.CorrectedReturnIndicatorG
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 1)
+	c.Assert(report.Errors[0].Error(), check.Equals, "B record #4 CorrectedReturnIndicator (columns 6-6): should be reported under a separate “A” record from payees with a different corrected return indicator")
+	c.Assert(report.Errors[0].Code, check.Equals, utils.CodeMixedCorrections)
+}
@@ -0,0 +1,565 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package file
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/moov-io/irs/pkg/subrecords"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+const (
+	csvPayerTIN     = "payer_tin"
+	csvTypeOfReturn = "type_of_return"
+)
+
+// CsvColumn maps a column of csv files to a field of records
+type CsvColumn struct {
+	// Header of the column
+	Header string `json:"header"`
+
+	// JSON field name of payee “B” records, their extension blocks or payer “A” records,
+	// or label of an amount code of the type of return, such as “Rents” of 1099-MISC
+	Field string `json:"field"`
+}
+
+// CsvMapping maps columns of csv files to fields of records, in order of columns of exported files
+type CsvMapping []CsvColumn
+
+// field returns the field of the column with the header, or the header itself if the column isn't mapped
+func (m CsvMapping) field(header string) string {
+	header = strings.TrimSpace(header)
+	for _, column := range m {
+		if strings.EqualFold(column.Header, header) {
+			return column.Field
+		}
+	}
+	return header
+}
+
+// ImportCsv adds payees of csv rows to payers of the file with the same payer TIN and type of return,
+// or to new payers created from payer “A” record fields of the rows.
+//
+// Columns are mapped by the mapping, and columns without mapping are read as fields named by their headers.
+// Every row requires payer TIN (payer_tin) and type of return (type_of_return), either the code of the payer “A” record
+// or the form, such as “1099-MISC”. Payment amounts and taxes withheld are in dollars, such as 1,234.56.
+//
+// Rows of an existing payer may repeat its payer “A” record fields, and a row with a different value of a field of the payer
+// is reported as a problem of the row.
+//
+// When some rows can't be imported the file is unchanged and the returned *utils.ValidationReport describes problems
+// of each row, otherwise end of payer “C” and state totals “K” records of payers with imported payees are generated,
+// records are renumbered and end of transmission “F” record is generated.
+func ImportCsv(f File, r io.Reader, mapping CsvMapping) error {
+	instance, ok := f.(*fileInstance)
+	if !ok || instance.Transmitter == nil || instance.EndTransmitter == nil {
+		return utils.ErrInvalidFile
+	}
+	transmitter, ok := instance.Transmitter.(*records.TRecord)
+	if !ok {
+		return utils.ErrInvalidFile
+	}
+
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return errors.New("csv file has no header")
+	}
+	fields := make([]string, len(rows[0]))
+	for i, header := range rows[0] {
+		fields[i] = mapping.field(header)
+	}
+
+	persons := make(map[string]*paymentPerson)
+	for _, person := range instance.PaymentPersons {
+		if payer, ok := person.Payer.(*records.ARecord); ok {
+			persons[payer.TIN+payer.TypeOfReturn] = person
+		}
+	}
+
+	report := &utils.ValidationReport{}
+	var added []*paymentPerson
+	// rows of new payers, which are validated once amount codes of their payees are known
+	addedRows := make(map[*paymentPerson]int)
+	imported := make(map[*paymentPerson][]records.Record)
+	for i, row := range rows[1:] {
+		// rows are numbered like spreadsheets, the header is the first row
+		number := i + 2
+		values, err := readCsvRow(fields, row)
+		if err != nil {
+			report.AddRow(number, err)
+			continue
+		}
+
+		payer, err := newCsvPayer(values, transmitter.PaymentYear)
+		if err != nil {
+			report.AddRow(number, err)
+			continue
+		}
+		person, ok := persons[payer.TIN+payer.TypeOfReturn]
+		if ok {
+			if err := checkCsvPayer(values, payer, person.Payer.(*records.ARecord)); err != nil {
+				report.AddRow(number, err)
+				continue
+			}
+		} else {
+			// provisional sequence number, records are renumbered once all rows are imported
+			payer.SetSequenceNumber(number)
+			person = &paymentPerson{Payer: payer}
+			persons[payer.TIN+payer.TypeOfReturn] = person
+			added = append(added, person)
+			addedRows[person] = number
+		}
+
+		payee, err := newCsvPayee(values, person.Payer.(*records.ARecord))
+		if err != nil {
+			report.AddRow(number, err)
+			continue
+		}
+		payee.SetSequenceNumber(number)
+		if err := payee.Validate(); err != nil {
+			report.AddRow(number, err)
+			continue
+		}
+		imported[person] = append(imported[person], payee)
+	}
+	for _, person := range added {
+		payer := person.Payer.(*records.ARecord)
+		payer.AmountCodes = usedAmountCodes("", imported[person])
+		if err := payer.Validate(); err != nil {
+			report.AddRow(addedRows[person], err)
+		}
+	}
+	if err := report.Err(); err != nil {
+		return err
+	}
+
+	instance.PaymentPersons = append(instance.PaymentPersons, added...)
+	for _, person := range instance.PaymentPersons {
+		payees, ok := imported[person]
+		if !ok {
+			continue
+		}
+		payer := person.Payer.(*records.ARecord)
+		payer.AmountCodes = usedAmountCodes(payer.AmountCodes, payees)
+		person.Payees = append(person.Payees, payees...)
+		if err := person.GenerateEndPayer(); err != nil {
+			return err
+		}
+		if err := person.GenerateStates(); err != nil {
+			return err
+		}
+	}
+
+	instance.Renumber()
+	return instance.GenerateEndTransmitter()
+}
+
+// ExportCsv writes a csv row of every payee “B” record of the file, with columns of the mapping.
+// Without mapping, columns are payer TIN, type of return and all fields of payee “B” records and their extension blocks.
+// Payment amounts and taxes withheld are written in dollars, such as 1234.56.
+func ExportCsv(f File, w io.Writer, mapping CsvMapping) error {
+	instance, ok := f.(*fileInstance)
+	if !ok {
+		return utils.ErrInvalidFile
+	}
+	if len(mapping) == 0 {
+		mapping = defaultCsvMapping(instance)
+	}
+
+	writer := csv.NewWriter(w)
+	headers := make([]string, len(mapping))
+	for i, column := range mapping {
+		headers[i] = column.Header
+	}
+	if err := writer.Write(headers); err != nil {
+		return err
+	}
+
+	for _, person := range instance.PaymentPersons {
+		payer, ok := person.Payer.(*records.ARecord)
+		if !ok {
+			return utils.ErrInvalidFile
+		}
+		payerValues, err := jsonValues(payer)
+		if err != nil {
+			return err
+		}
+		typeOfReturn := config.TypeOfReturns[payer.TypeOfReturn]
+
+		for _, payee := range person.Payees {
+			payeeValues, err := jsonValues(payee)
+			if err != nil {
+				return err
+			}
+
+			row := make([]string, len(mapping))
+			for i, column := range mapping {
+				name := column.Field
+				if code, ok := amountCodeOfLabel(typeOfReturn, name); ok {
+					name = paymentAmountField(code)
+				}
+				value, ok := payeeValues[name]
+				if !ok {
+					value = payerValues[name]
+				}
+				row[i] = formatCsvValue(name, value)
+			}
+			if err := writer.Write(row); err != nil {
+				return err
+			}
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// defaultCsvMapping returns columns of all fields of payee “B” records and extension blocks of types of return of the file
+func defaultCsvMapping(f *fileInstance) CsvMapping {
+	mapping := CsvMapping{{Header: csvPayerTIN, Field: csvPayerTIN}, {Header: csvTypeOfReturn, Field: csvTypeOfReturn}}
+	seen := map[string]bool{csvPayerTIN: true, csvTypeOfReturn: true}
+	add := func(names []string) {
+		for _, name := range names {
+			if !seen[name] {
+				seen[name] = true
+				mapping = append(mapping, CsvColumn{Header: name, Field: name})
+			}
+		}
+	}
+
+	// type and sequence number of records are generated by imports
+	seen["record_type"], seen["record_sequence_number"] = true, true
+	add(jsonFieldNames(records.BRecord{}))
+	for _, person := range f.PaymentPersons {
+		if payer, ok := person.Payer.(*records.ARecord); ok {
+			if ext := subrecords.NewSubRecord(config.TypeOfReturns[payer.TypeOfReturn]); ext != nil {
+				add(jsonFieldNames(ext))
+			}
+		}
+	}
+	return mapping
+}
+
+// readCsvRow returns values of the row keyed by fields of columns
+func readCsvRow(fields []string, row []string) (map[string]string, error) {
+	if len(row) != len(fields) {
+		return nil, fmt.Errorf("has %d columns, expected %d", len(row), len(fields))
+	}
+	values := make(map[string]string)
+	for i, field := range fields {
+		if value := strings.TrimSpace(row[i]); len(value) > 0 {
+			values[field] = value
+		}
+	}
+	return values, nil
+}
+
+// newCsvPayer returns payer “A” record of payer fields of the row
+func newCsvPayer(values map[string]string, paymentYear int) (*records.ARecord, error) {
+	if len(values[csvPayerTIN]) == 0 {
+		return nil, utils.NewErrFieldRequired(csvPayerTIN)
+	}
+	code, ok := typeOfReturnCode(values[csvTypeOfReturn])
+	if !ok {
+		return nil, utils.NewErrValidValue(csvTypeOfReturn)
+	}
+
+	payer := records.NewARecord().(*records.ARecord)
+	if err := unmarshalCsvValues(values, payer); err != nil {
+		return nil, err
+	}
+	payer.RecordType = config.ARecordType
+	payer.TypeOfReturn = code
+	if payer.PaymentYear == 0 {
+		payer.PaymentYear = paymentYear
+	}
+	return payer, nil
+}
+
+// checkCsvPayer checks payer fields of the row with the existing payer “A” record.
+// Payer TIN and type of return identify the payer, and amount codes are generated from payees.
+func checkCsvPayer(values map[string]string, payer, existing *records.ARecord) error {
+	rowValues, err := jsonValues(payer)
+	if err != nil {
+		return err
+	}
+	existingValues, err := jsonValues(existing)
+	if err != nil {
+		return err
+	}
+
+	report := &utils.ValidationReport{}
+	for _, name := range csvPayerFields() {
+		if name == csvPayerTIN || name == csvTypeOfReturn || name == "amount_codes" {
+			continue
+		}
+		value, ok := values[name]
+		if !ok || rowValues[name] == existingValues[name] {
+			continue
+		}
+		err := utils.NewErrMismatchedField(name, config.ARecordType, existingValues[name])
+		report.Add(nil, &utils.FieldError{FieldName: name, Value: value, Code: utils.ErrorCode(err), Message: err.Error(), Err: err})
+	}
+	return report.Err()
+}
+
+// csvPayerFields returns JSON field names of payer “A” records that are read from rows, in order of fields
+func csvPayerFields() []string {
+	var names []string
+	for _, name := range jsonFieldNames(records.ARecord{}) {
+		// type and sequence number of records are generated by imports
+		if name != "record_type" && name != "record_sequence_number" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// newCsvPayee returns payee “B” record of payee fields of the row, skipping payer fields read by newCsvPayer
+func newCsvPayee(values map[string]string, payer *records.ARecord) (*records.BRecord, error) {
+	typeOfReturn := config.TypeOfReturns[payer.TypeOfReturn]
+	payee := records.NewBRecord(typeOfReturn).(*records.BRecord)
+
+	payeeFields := make(map[string]bool)
+	for _, name := range jsonFieldNames(records.BRecord{}) {
+		payeeFields[name] = true
+	}
+	for _, name := range jsonFieldNames(payee.SubRecord()) {
+		payeeFields[name] = true
+	}
+	payerFields := make(map[string]bool)
+	for _, name := range csvPayerFields() {
+		payerFields[name] = true
+	}
+
+	payeeValues := make(map[string]string)
+	for name, value := range values {
+		if code, ok := amountCodeOfLabel(typeOfReturn, name); ok {
+			name = paymentAmountField(code)
+		}
+		if payeeFields[name] {
+			payeeValues[name] = value
+		} else if !payerFields[name] {
+			return nil, utils.NewErrUnknownField(name, typeOfReturn)
+		}
+	}
+
+	if err := unmarshalCsvValues(payeeValues, payee); err != nil {
+		return nil, err
+	}
+	payee.RecordType = config.BRecordType
+	if payee.PaymentYear == 0 {
+		payee.PaymentYear = payer.PaymentYear
+	}
+	return payee, nil
+}
+
+// unmarshalCsvValues sets fields of the record with values keyed by JSON field names of the record,
+// ignoring values of other fields
+func unmarshalCsvValues(values map[string]string, record records.Record) error {
+	kinds := jsonFieldKinds(record)
+	if payee, ok := record.(*records.BRecord); ok && payee.SubRecord() != nil {
+		for name, kind := range jsonFieldKinds(payee.SubRecord()) {
+			kinds[name] = kind
+		}
+	}
+
+	report := &utils.ValidationReport{}
+	jsonMap := make(map[string]interface{})
+	for name, value := range values {
+		kind, ok := kinds[name]
+		if !ok {
+			continue
+		}
+		if kind != reflect.Int {
+			jsonMap[name] = value
+			continue
+		}
+		number, err := parseCsvNumber(name, value)
+		if err != nil {
+			report.Add(nil, &utils.FieldError{FieldName: name, Value: value, Code: utils.ErrorCode(err), Message: err.Error(), Err: err})
+			continue
+		}
+		jsonMap[name] = number
+	}
+	if err := report.Err(); err != nil {
+		return err
+	}
+
+	buf, err := json.Marshal(jsonMap)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf, record)
+}
+
+// isCsvAmount returns true if the field is an amount of money, which is written in dollars in csv files
+func isCsvAmount(name string) bool {
+	return strings.HasPrefix(name, "payment_amount_") || strings.HasSuffix(name, "_withheld")
+}
+
+// parseCsvNumber parses integer value of the field, converting amounts of dollars such as $1,234.56 to cents
+func parseCsvNumber(name, value string) (int, error) {
+	value = strings.NewReplacer(",", "", "$", "").Replace(value)
+	if !isCsvAmount(name) {
+		number, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, utils.ErrNumeric
+		}
+		return number, nil
+	}
+
+	dollars, cents := value, ""
+	if i := strings.IndexByte(value, '.'); i >= 0 {
+		dollars, cents = value[:i], value[i+1:]
+	}
+	if len(cents) > 2 {
+		return 0, utils.ErrNumeric
+	}
+	cents += strings.Repeat("0", 2-len(cents))
+	if _, err := strconv.ParseUint(cents, 10, 64); err != nil {
+		return 0, utils.ErrNumeric
+	}
+	number, err := strconv.Atoi(dollars + cents)
+	if err != nil {
+		return 0, utils.ErrNumeric
+	}
+	return number, nil
+}
+
+// formatCsvValue formats a JSON value of the field, writing amounts in dollars
+func formatCsvValue(name string, value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		if isCsvAmount(name) {
+			cents := int64(v)
+			sign := ""
+			if cents < 0 {
+				sign, cents = "-", -cents
+			}
+			return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+		}
+		return strconv.FormatInt(int64(v), 10)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// jsonValues returns JSON values of the record keyed by field names
+func jsonValues(record interface{}) (map[string]interface{}, error) {
+	buf, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]interface{})
+	return values, json.Unmarshal(buf, &values)
+}
+
+// jsonFieldKinds returns kinds of exported fields of the struct keyed by JSON field names
+func jsonFieldKinds(v interface{}) map[string]reflect.Kind {
+	kinds := make(map[string]reflect.Kind)
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return kinds
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if len(field.PkgPath) == 0 && len(name) > 0 && name != "-" {
+			kinds[name] = field.Type.Kind()
+		}
+	}
+	return kinds
+}
+
+// jsonFieldNames returns JSON field names of exported fields of the struct, in order of fields
+func jsonFieldNames(v interface{}) []string {
+	var names []string
+	t := reflect.TypeOf(v)
+	if t == nil {
+		return names
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if len(field.PkgPath) == 0 && len(name) > 0 && name != "-" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// typeOfReturnCode returns code of the type of return, given either the code or the form
+func typeOfReturnCode(value string) (string, bool) {
+	if _, ok := config.TypeOfReturns[value]; ok {
+		return value, true
+	}
+	for code, form := range config.TypeOfReturns {
+		if strings.EqualFold(form, value) {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// amountCodeOfLabel returns the amount code of the label of the type of return
+func amountCodeOfLabel(typeOfReturn, label string) (string, bool) {
+	for code, description := range config.AmountCodes[typeOfReturn] {
+		if strings.EqualFold(description, label) {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+func paymentAmountField(code string) string {
+	return "payment_amount_" + code
+}
+
+// usedAmountCodes returns the amount codes with codes of non-zero payment amounts of the payees
+func usedAmountCodes(codes string, payees []records.Record) string {
+	used := make(map[string]bool)
+	for _, code := range codes {
+		used[string(code)] = true
+	}
+	for _, record := range payees {
+		if payee, ok := record.(*records.BRecord); ok {
+			for code, amount := range payee.PaymentAmounts() {
+				used[code] = used[code] || amount != 0
+			}
+		}
+	}
+
+	codes = ""
+	for _, code := range config.PaymentAmountCodes {
+		if used[code] {
+			codes += code
+		}
+	}
+	return codes
+}
@@ -0,0 +1,163 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package file
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+
+	"gopkg.in/check.v1"
+
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+func (t *FileTest) TestExportCsv(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+
+	buf := &bytes.Buffer{}
+	c.Assert(ExportCsv(f, buf, nil), check.IsNil)
+	rows, err := csv.NewReader(buf).ReadAll()
+	c.Assert(err, check.IsNil)
+	c.Assert(rows, check.HasLen, 3)
+	c.Assert(rows[0][:3], check.DeepEquals, []string{"payer_tin", "type_of_return", "payment_year"})
+	values := make(map[string]string)
+	for i, header := range rows[0] {
+		values[header] = rows[1][i]
+	}
+	c.Assert(values["payer_tin"], check.Equals, "123456789")
+	c.Assert(values["type_of_return"], check.Equals, "A")
+	c.Assert(values["payees_tin"], check.Equals, "987654321")
+	c.Assert(values["payment_amount_7"], check.Equals, "7.00")
+	c.Assert(values["state_income_tax_withheld"], check.Equals, "0.04")
+	c.Assert(values["combined_federal_state_code"], check.Equals, "1")
+	c.Assert(values["payee_city"], check.Equals, "MOON")
+
+	mapping := CsvMapping{
+		{Header: "Payee", Field: "first_payee_name_line"},
+		{Header: "Payer", Field: "first_payer_name"},
+		{Header: "NEC", Field: "nonemployee compensation (NEC)"},
+	}
+	buf.Reset()
+	c.Assert(ExportCsv(f, buf, mapping), check.IsNil)
+	c.Assert(strings.Split(buf.String(), "\n")[:2], check.DeepEquals, []string{
+		"Payee,Payer,NEC",
+		"SPACELEY SPROCKETS,ASDF GLOBAL INC,7.00",
+	})
+}
+
+func (t *FileTest) TestImportCsvExportedFile(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	buf := &bytes.Buffer{}
+	c.Assert(ExportCsv(f, buf, nil), check.IsNil)
+
+	c.Assert(ImportCsv(f, bytes.NewReader(buf.Bytes()), nil), check.IsNil)
+	c.Assert(f.Validate(), check.IsNil)
+	instance := f.(*fileInstance)
+	c.Assert(instance.PaymentPersons, check.HasLen, 1)
+	person := instance.PaymentPersons[0]
+	c.Assert(person.Payees, check.HasLen, 4)
+	c.Assert(person.EndPayer.(*records.CRecord).NumberPayees, check.Equals, 4)
+	c.Assert(person.Payees[2].(*records.BRecord).PaymentAmount7, check.Equals, 700)
+	c.Assert(person.Payees[2].(*records.BRecord).StateIncomeTaxWithheld(), check.Equals, 4)
+	c.Assert(instance.EndTransmitter.(*records.FRecord).TotalNumberPayees, check.Equals, 4)
+}
+
+func (t *FileTest) TestImportCsvNewPayer(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	mapping := CsvMapping{
+		{Header: "Payer TIN", Field: "payer_tin"},
+		{Header: "Form", Field: "type_of_return"},
+		{Header: "Payer", Field: "first_payer_name"},
+		{Header: "Payee TIN", Field: "payees_tin"},
+		{Header: "Payee", Field: "first_payee_name_line"},
+	}
+	data := "Payer TIN,Form,Payer,Payee TIN,Payee,payer_name_control,payer_shipping_address,payer_city,payer_state,payer_zip_code,transfer_agent_control,payer_telephone_number_and_ext,payee_mailing_address,payee_city,payee_state,payee_zip_code,Rents,Royalties\n" +
+		"123456789,1099-MISC,,111111111,ACME,,,,,,,,1 MAIN ST,MOON,CA,22222,\"$1,200.50\",\n" +
+		"111222333,1099-MISC,OTHER CORP,222222222,WIDGETS INC,OTHE,2 OAK ST,NEW YORK,NY,10001,0,5555555555,3 ELM ST,MOON,CA,22222,,30\n"
+
+	c.Assert(ImportCsv(f, strings.NewReader(data), mapping), check.IsNil)
+	c.Assert(f.Validate(), check.IsNil)
+	instance := f.(*fileInstance)
+	c.Assert(instance.PaymentPersons, check.HasLen, 2)
+	c.Assert(instance.PaymentPersons[0].Payees, check.HasLen, 3)
+	payee := instance.PaymentPersons[0].Payees[2].(*records.BRecord)
+	c.Assert(payee.PaymentAmount1, check.Equals, 120050)
+	c.Assert(payee.PaymentYear, check.Equals, 2017)
+
+	person := instance.PaymentPersons[1]
+	payer := person.Payer.(*records.ARecord)
+	c.Assert(payer.TypeOfReturn, check.Equals, "A")
+	c.Assert(payer.FirstPayerNameLine, check.Equals, "OTHER CORP")
+	c.Assert(payer.AmountCodes, check.Equals, "2")
+	c.Assert(person.Payees, check.HasLen, 1)
+	c.Assert(person.Payees[0].(*records.BRecord).PaymentAmount2, check.Equals, 3000)
+	c.Assert(person.Payees[0].SequenceNumber(), check.Equals, payer.SequenceNumber()+1)
+}
+
+func (t *FileTest) TestImportCsvWithInvalidRows(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	before := string(f.Ascii())
+
+	data := "payer_tin,type_of_return,payees_tin,payee_city,payment_amount_1,favorite_color\n" +
+		"123456789,A,111111111,MOON,12.345,\n" +
+		"123456789,A,111111111,MOON,1,blue\n" +
+		",A,111111111,MOON,1,\n" +
+		"123456789,1099-XYZ,111111111,MOON,1,\n"
+	err = ImportCsv(f, strings.NewReader(data), nil)
+	report, ok := err.(*utils.ValidationReport)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(report.Errors, check.HasLen, 4)
+	c.Assert(report.Errors[0].Row, check.Equals, 2)
+	c.Assert(report.Errors[0].FieldName, check.Equals, "payment_amount_1")
+	c.Assert(report.Errors[0].Code, check.Equals, utils.CodeNumeric)
+	c.Assert(report.Errors[1].Row, check.Equals, 3)
+	c.Assert(report.Errors[1].Code, check.Equals, utils.CodeUnknownField)
+	c.Assert(report.Errors[2].Row, check.Equals, 4)
+	c.Assert(report.Errors[2].Code, check.Equals, utils.CodeFieldRequired)
+	c.Assert(report.Errors[3].Row, check.Equals, 5)
+	c.Assert(string(f.Ascii()), check.Equals, before)
+
+	c.Assert(ImportCsv(f, strings.NewReader(""), nil), check.NotNil)
+	c.Assert(ImportCsv(f, strings.NewReader("payer_tin\n1,2\n"), nil), check.NotNil)
+}
+
+func (t *FileTest) TestImportCsvConflictingPayer(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	before := string(f.Ascii())
+
+	data := "payer_tin,type_of_return,first_payer_name,payees_tin,first_payee_name_line,payee_mailing_address,payee_city,payee_state,payee_zip_code,payment_amount_1\n" +
+		"123456789,A,ASDF GLOBAL INC,111111111,ACME,1 MAIN ST,MOON,CA,22222,1\n" +
+		"123456789,A,OTHER CORP,222222222,WIDGETS INC,3 ELM ST,MOON,CA,22222,1\n"
+	err = ImportCsv(f, strings.NewReader(data), nil)
+	report, ok := err.(*utils.ValidationReport)
+	c.Assert(ok, check.Equals, true)
+	c.Assert(report.Errors, check.HasLen, 1)
+	c.Assert(report.Errors[0].Row, check.Equals, 3)
+	c.Assert(report.Errors[0].FieldName, check.Equals, "first_payer_name")
+	c.Assert(report.Errors[0].Code, check.Equals, utils.CodeMismatchedField)
+	c.Assert(string(f.Ascii()), check.Equals, before)
+}
+
+func (t *FileTest) TestImportCsvKeepsTotalsOfOtherPayers(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	person := f.(*fileInstance).PaymentPersons[0]
+	endPayer, states := person.EndPayer, person.States
+
+	data := "payer_tin,type_of_return,first_payer_name,payer_name_control,payer_shipping_address,payer_city,payer_state,payer_zip_code,transfer_agent_control,payer_telephone_number_and_ext,payees_tin,first_payee_name_line,payee_mailing_address,payee_city,payee_state,payee_zip_code,payment_amount_1\n" +
+		"111222333,A,OTHER CORP,OTHE,2 OAK ST,NEW YORK,NY,10001,0,5555555555,222222222,WIDGETS INC,3 ELM ST,MOON,CA,22222,1\n"
+	c.Assert(ImportCsv(f, strings.NewReader(data), nil), check.IsNil)
+	c.Assert(f.Validate(), check.IsNil)
+	c.Assert(f.(*fileInstance).PaymentPersons, check.HasLen, 2)
+	c.Assert(person.EndPayer, check.Equals, endPayer)
+	c.Assert(person.States, check.DeepEquals, states)
+}
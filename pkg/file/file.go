@@ -11,6 +11,18 @@ type File interface {
 	Parse([]byte) error
 	Ascii() []byte
 	Validate() error
+	GenerateEndPayers() error
+	GenerateStates() error
+	GenerateEndTransmitter() error
+	Renumber()
+	SetValidation(*ValidateOpts)
+}
+
+// ValidateOpts contains options of validating a file
+type ValidateOpts struct {
+	// TaxYear is the tax year of the filing season, such as 2020 for files submitted in 2021.
+	// Prior year data indicator of transmitter “T” record is checked with its payment year only when the tax year is set.
+	TaxYear int
 }
 
 // NewFile constructs a file template.
@@ -22,15 +34,16 @@ func NewFile() File {
 }
 
 // CreateFile attempts to parse raw metro2 file contents
+//
+// Files are kept as they are given, in JSON or fire ascii, so that validation reports problems of the file
+// such as mismatched totals or sequence numbers. Call GenerateEndPayers, GenerateStates, GenerateEndTransmitter
+// and Renumber to populate totals and sequence numbers from the file contents.
 func CreateFile(buf []byte) (File, error) {
-	var err error
 	f := NewFile()
-	if json.Valid(buf) {
-		err = json.Unmarshal(buf, f)
-	} else {
-		err = f.Parse(buf)
+	if !json.Valid(buf) {
+		return f, f.Parse(buf)
 	}
-	return f, err
+	return f, json.Unmarshal(buf, f)
 }
 
 func readJsonWithRecord(record records.Record, data interface{}) error {
@@ -3,6 +3,8 @@ package file
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
+
 	"github.com/moov-io/irs/pkg/config"
 	"github.com/moov-io/irs/pkg/records"
 	"github.com/moov-io/irs/pkg/utils"
@@ -13,28 +15,76 @@ type fileInstance struct {
 	Transmitter    records.Record   `json:"transmitter"`
 	PaymentPersons []*paymentPerson `json:"payment_persons"`
 	EndTransmitter records.Record   `json:"end_transmitter"`
+
+	validateOpts *ValidateOpts
+}
+
+// SetValidation sets options of validating the file
+func (f *fileInstance) SetValidation(opts *ValidateOpts) {
+	f.validateOpts = opts
 }
 
 // Validate performs some checks on the file and returns an error if not Validated
+// The returned error is a validation report that collects all problems of the file
 func (f *fileInstance) Validate() error {
-	err := f.validateRecords()
-	if err != nil {
-		return err
+	report := &utils.ValidationReport{}
+	report.Add(nil, f.validateRecords())
+	for _, rule := range fileRules {
+		report.Add(nil, rule(f))
+	}
+	return report.Err()
+}
+
+// GenerateEndPayers replaces end payer “C” records with totals accumulated from payee “B” records
+func (f *fileInstance) GenerateEndPayers() error {
+	for _, person := range f.PaymentPersons {
+		if err := person.GenerateEndPayer(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GenerateStates replaces state totals “K” records of all payers with totals accumulated from payee “B” records
+// grouped by CF/SF code, and renumbers records of the file since the number of “K” records may change
+func (f *fileInstance) GenerateStates() error {
+	for _, person := range f.PaymentPersons {
+		if err := person.GenerateStates(); err != nil {
+			return err
+		}
 	}
+	f.Renumber()
+	return nil
+}
 
-	err = f.validateSequenceNumber()
+// GenerateEndTransmitter populates number of payer “A” records and total number of payee “B” records
+// of end of transmission “F” record, along with total number of payees of transmitter “T” record
+func (f *fileInstance) GenerateEndTransmitter() error {
+	transmitter, endTransmitter, err := f.transmissionRecords()
 	if err != nil {
 		return err
 	}
 
+	numberPayers, numberPayees := f.countPayersAndPayees()
+	transmitter.TotalNumberPayees = numberPayees
+	endTransmitter.NumberPayerRecords = numberPayers
+	endTransmitter.TotalNumberPayees = numberPayees
+
 	return nil
 }
 
+// Renumber rewrites record sequence numbers of all records in ascending order, starting with “1” for the “T” record
+func (f *fileInstance) Renumber() {
+	for index, record := range f.records() {
+		record.SetSequenceNumber(index + 1)
+	}
+}
+
 // Parse attempts to initialize a *File object assuming the input is valid raw data.
 func (f *fileInstance) Parse(buf []byte) error {
 	bufSize := len(buf)
 	readPtr := 0
-	if string(buf[readPtr]) != config.TRecordType || bufSize < config.RecordLength {
+	if bufSize < config.RecordLength || string(buf[readPtr]) != config.TRecordType {
 		return utils.ErrInvalidAscii
 	}
 
@@ -48,7 +98,7 @@ func (f *fileInstance) Parse(buf []byte) error {
 	readPtr += config.RecordLength
 
 	f.PaymentPersons = []*paymentPerson{}
-	for string(buf[readPtr]) == config.ARecordType {
+	for readPtr < bufSize && string(buf[readPtr]) == config.ARecordType {
 		currentPerson := &paymentPerson{}
 		readSize, err := currentPerson.Parse(buf[readPtr:])
 		if err != nil {
@@ -58,7 +108,7 @@ func (f *fileInstance) Parse(buf []byte) error {
 		f.PaymentPersons = append(f.PaymentPersons, currentPerson)
 	}
 
-	if string(buf[readPtr]) != config.FRecordType || bufSize < readPtr+config.RecordLength {
+	if bufSize < readPtr+config.RecordLength || string(buf[readPtr]) != config.FRecordType {
 		return utils.ErrInvalidAscii
 	}
 
@@ -153,26 +203,134 @@ func (f *fileInstance) validateRecords() error {
 		return utils.ErrInvalidFile
 	}
 
-	err := f.Transmitter.Validate()
-	if err != nil {
-		return err
-	}
+	report := &utils.ValidationReport{}
+	report.Add(f.Transmitter, f.Transmitter.Validate())
 
 	for _, person := range f.PaymentPersons {
-		err = person.Validate()
-		if err != nil {
+		report.Add(person.Payer, person.Validate())
+	}
+
+	report.Add(f.EndTransmitter, f.EndTransmitter.Validate())
+
+	return report.Err()
+}
+
+func (f *fileInstance) validateSequenceNumber() error {
+	numbers := &sequenceNumbers{}
+	for index, record := range f.records() {
+		if err := numbers.check(record, index+1); err != nil {
 			return err
 		}
 	}
+	return nil
+}
+
+// sequenceNumbers checks sequence numbers of records in file order.
+// Only records numbered differently from their position are kept, since a number below the position
+// is duplicated unless the record at that position was numbered differently.
+type sequenceNumbers struct {
+	// sequence numbers of records that aren't their position
+	misplaced map[int]bool
+	// positions of records that have a sequence number different from the position
+	misnumbered map[int]bool
+}
+
+// check checks that sequence number of the record is its position in the file, and that no record checked before has the number
+func (s *sequenceNumbers) check(record records.Record, position int) error {
+	number := record.SequenceNumber()
+	duplicated := s.misplaced[number] || (number > 0 && number < position && !s.misnumbered[number])
+	if number == position && !duplicated {
+		return nil
+	}
+	if number != position {
+		if s.misplaced == nil {
+			s.misplaced, s.misnumbered = make(map[int]bool), make(map[int]bool)
+		}
+		s.misplaced[number], s.misnumbered[position] = true, true
+	}
+
+	var err error
+	if duplicated {
+		err = utils.NewErrDuplicatedSequenceNumber(record.Type(), position, number)
+	} else {
+		err = utils.NewErrSequenceNumber(record.Type(), position, position, number)
+	}
+	spec := config.RecordLayouts[record.Type()]["RecordSequenceNumber"]
+	report := &utils.ValidationReport{}
+	report.Add(record, utils.NewFieldError("RecordSequenceNumber", spec, record.SequenceNumber(), err))
+	return report.Err()
+}
+
+// records returns all records of the file in file order
+func (f *fileInstance) records() []records.Record {
+	var list []records.Record
+	if f.Transmitter != nil {
+		list = append(list, f.Transmitter)
+	}
+	for _, person := range f.PaymentPersons {
+		list = append(list, person.records()...)
+	}
+	if f.EndTransmitter != nil {
+		list = append(list, f.EndTransmitter)
+	}
+	return list
+}
+
+// validateTransmissionTotals checks totals of transmitter “T” and end of transmission “F” records with contents of the file
+func (f *fileInstance) validateTransmissionTotals() error {
+	if f.Transmitter == nil || f.EndTransmitter == nil {
+		// reported by validateRecords
+		return nil
+	}
 
-	err = f.EndTransmitter.Validate()
+	transmitter, endTransmitter, err := f.transmissionRecords()
 	if err != nil {
 		return err
 	}
 
-	return nil
+	numberPayers, numberPayees := f.countPayersAndPayees()
+	return checkTransmissionTotals(transmitter, endTransmitter, numberPayers, numberPayees)
 }
 
-func (f *fileInstance) validateSequenceNumber() error {
-	return nil
+// checkTransmissionTotals checks totals of transmitter “T” and end of transmission “F” records with numbers of payer “A”
+// and payee “B” records
+func checkTransmissionTotals(transmitter *records.TRecord, endTransmitter *records.FRecord, numberPayers, numberPayees int) error {
+	report := &utils.ValidationReport{}
+	if transmitter.TotalNumberPayees != numberPayees {
+		err := utils.NewErrUnexpectedTotal("TotalNumberPayees", numberPayees, transmitter.TotalNumberPayees)
+		report.Add(transmitter, utils.NewFieldError("TotalNumberPayees", config.TRecordLayout["TotalNumberPayees"], transmitter.TotalNumberPayees, err))
+	}
+	if endTransmitter.NumberPayerRecords != numberPayers {
+		err := utils.NewErrUnexpectedTotal("NumberPayerRecords", numberPayers, endTransmitter.NumberPayerRecords)
+		report.Add(endTransmitter, utils.NewFieldError("NumberPayerRecords", config.FRecordLayout["NumberPayerRecords"], endTransmitter.NumberPayerRecords, err))
+	}
+	// the total may be blank filled in the “F” record if it was entered in the “T” record
+	if endTransmitter.TotalNumberPayees != 0 && endTransmitter.TotalNumberPayees != numberPayees {
+		err := utils.NewErrUnexpectedTotal("TotalNumberPayees", numberPayees, endTransmitter.TotalNumberPayees)
+		report.Add(endTransmitter, utils.NewFieldError("TotalNumberPayees", config.FRecordLayout["TotalNumberPayees"], endTransmitter.TotalNumberPayees, err))
+	}
+
+	return report.Err()
+}
+
+// transmissionRecords returns transmitter “T” and end of transmission “F” records of the file
+func (f *fileInstance) transmissionRecords() (*records.TRecord, *records.FRecord, error) {
+	transmitter, ok := f.Transmitter.(*records.TRecord)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected Transmitter to be a TRecord, but got %T", f.Transmitter)
+	}
+	endTransmitter, ok := f.EndTransmitter.(*records.FRecord)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected EndTransmitter to be a FRecord, but got %T", f.EndTransmitter)
+	}
+	return transmitter, endTransmitter, nil
+}
+
+// countPayersAndPayees returns number of payer “A” records and payee “B” records in the file
+func (f *fileInstance) countPayersAndPayees() (int, int) {
+	numberPayees := 0
+	for _, person := range f.PaymentPersons {
+		numberPayees += len(person.Payees)
+	}
+	return len(f.PaymentPersons), numberPayees
 }
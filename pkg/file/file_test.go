@@ -3,7 +3,11 @@ package file
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"gopkg.in/check.v1"
+
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/moov-io/irs/pkg/utils"
 )
 
 func (t *FileTest) TestParseWithOneTransactionJsonFile(c *check.C) {
@@ -24,9 +28,355 @@ func (t *FileTest) TestParseWithOneTransactionJsonFile(c *check.C) {
 	c.Assert(prettyJSON1.String(), check.Equals, prettyJSON2.String())
 }
 
+func (t *FileTest) TestParseWithTruncatedAscii(c *check.C) {
+	ascii := t.oneTransactionAscii
+	// truncated after transmitter, payer, payees, end of payer and state totals records,
+	// and in the middle of the end of payer record
+	for _, size := range []int{0, 1, 750, 1500, 3000, 3375, 3750, 4500} {
+		f := NewFile()
+		err := f.Parse(ascii[:size])
+		c.Assert(errors.Is(err, utils.ErrInvalidAscii), check.Equals, true, check.Commentf("size %d", size))
+	}
+}
+
 func (t *FileTest) TestValidateWithOneTransactionJsonFile(c *check.C) {
 	f, err := CreateFile(t.oneTransactionJson)
 	c.Assert(err, check.IsNil)
 	err = f.Validate()
 	c.Assert(err, check.IsNil)
 }
+
+func (t *FileTest) TestGenerateEndPayers(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	err = f.GenerateEndPayers()
+	c.Assert(err, check.IsNil)
+	c.Assert(f.Validate(), check.IsNil)
+	c.Assert(string(f.Ascii()), check.Equals, string(t.oneTransactionAscii))
+}
+
+func (t *FileTest) TestValidateWithUnexpectedEndPayer(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	endPayer := instance.PaymentPersons[0].EndPayer.(*records.CRecord)
+	endPayer.ControlTotal7++
+	c.Assert(f.Validate(), check.Not(check.IsNil))
+	endPayer.ControlTotal7--
+	endPayer.NumberPayees = 1
+	c.Assert(f.Validate(), check.Not(check.IsNil))
+	c.Assert(f.GenerateEndPayers(), check.IsNil)
+	c.Assert(f.Validate(), check.IsNil)
+}
+
+func (t *FileTest) TestValidateWithUnexpectedSequenceNumber(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	payees := instance.PaymentPersons[0].Payees
+	payees[1].SetSequenceNumber(payees[0].SequenceNumber())
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	c.Assert(err.Error(), check.Equals, "B record #3 RecordSequenceNumber (columns 500-507): has duplicated sequence number 3 (B record at position 4)")
+	instance.EndTransmitter.SetSequenceNumber(10)
+	payees[1].SetSequenceNumber(4)
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	c.Assert(err.Error(), check.Equals, "F record #10 RecordSequenceNumber (columns 500-507): has unexpected sequence number 10, expected 7 (F record at position 7)")
+}
+
+func (t *FileTest) TestValidateWithNonAdjacentDuplicatedSequenceNumber(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	// sequence numbers 1, 2, 3, 4, 2 of the payer, payees and end of payer
+	instance.PaymentPersons[0].EndPayer.SetSequenceNumber(2)
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	c.Assert(err.Error(), check.Equals, "C record #2 RecordSequenceNumber (columns 500-507): has duplicated sequence number 2 (C record at position 5)")
+	c.Assert(err.(*utils.ValidationReport).Errors[0].Code, check.Equals, utils.CodeDuplicatedSequence)
+}
+
+func (t *FileTest) TestCheckSequenceNumbers(c *check.C) {
+	numbers := &sequenceNumbers{}
+	code := func(number, position int) string {
+		record := records.NewCRecord()
+		record.SetSequenceNumber(number)
+		err := numbers.check(record, position)
+		if err == nil {
+			return ""
+		}
+		return err.(*utils.ValidationReport).Errors[0].Code
+	}
+	c.Assert(code(1, 1), check.Equals, "")
+	// record numbered ahead of its position, then the record numbered at its position
+	c.Assert(code(4, 2), check.Equals, utils.CodeUnexpectedSequence)
+	c.Assert(code(3, 3), check.Equals, "")
+	c.Assert(code(4, 4), check.Equals, utils.CodeDuplicatedSequence)
+	// the record at position 2 wasn't numbered 2
+	c.Assert(code(2, 5), check.Equals, utils.CodeUnexpectedSequence)
+	c.Assert(code(3, 6), check.Equals, utils.CodeDuplicatedSequence)
+	c.Assert(numbers.misplaced, check.HasLen, 3)
+}
+
+func (t *FileTest) TestRenumber(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	for _, record := range instance.records() {
+		record.SetSequenceNumber(1)
+	}
+	c.Assert(f.Validate(), check.Not(check.IsNil))
+	f.Renumber()
+	c.Assert(f.Validate(), check.IsNil)
+	c.Assert(string(f.Ascii()), check.Equals, string(t.oneTransactionAscii))
+}
+
+func (t *FileTest) TestValidateWithReport(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	payer := instance.PaymentPersons[0].Payer.(*records.ARecord)
+	payer.TIN = ""
+	payer.PayerState = "ZZ"
+	payee := instance.PaymentPersons[0].Payees[1].(*records.BRecord)
+	buf, err := json.Marshal(payee)
+	c.Assert(err, check.IsNil)
+	buf = bytes.Replace(buf, []byte(`"fatca_requirement_indicator":""`), []byte(`"fatca_requirement_indicator":"3"`), 1)
+	c.Assert(json.Unmarshal(buf, payee), check.IsNil)
+	payee.PayeeCity = ""
+	payee.PaymentAmount1 = 50
+
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report, ok := err.(*utils.ValidationReport)
+	c.Assert(ok, check.Equals, true)
+
+	expected := []utils.FieldError{
+		{RecordType: "A", SequenceNumber: 2, FieldName: "TIN", StartColumn: 12, EndColumn: 20, Code: utils.CodeFieldRequired},
+		{RecordType: "A", SequenceNumber: 2, FieldName: "PayerState", StartColumn: 214, EndColumn: 215, Value: "ZZ", Code: utils.CodeValidValue},
+		{RecordType: "B", SequenceNumber: 4, FieldName: "PayeeCity", StartColumn: 448, EndColumn: 487, Code: utils.CodeFieldRequired},
+		{RecordType: "B", SequenceNumber: 4, FieldName: "FATCA", StartColumn: 548, EndColumn: 548, Value: "3", Code: utils.CodeValidValue},
+		{RecordType: "C", SequenceNumber: 5, FieldName: "ControlTotal1", StartColumn: 16, EndColumn: 33, Value: "200", Code: utils.CodeUnexpectedTotal},
+		{RecordType: "K", SequenceNumber: 6, FieldName: "ControlTotal1", StartColumn: 16, EndColumn: 33, Value: "200", Code: utils.CodeUnexpectedTotal},
+	}
+	c.Assert(report.Errors, check.HasLen, len(expected))
+	for i, e := range expected {
+		actual := report.Errors[i]
+		c.Assert(actual.RecordType, check.Equals, e.RecordType)
+		c.Assert(actual.SequenceNumber, check.Equals, e.SequenceNumber)
+		c.Assert(actual.FieldName, check.Equals, e.FieldName)
+		c.Assert(actual.StartColumn, check.Equals, e.StartColumn)
+		c.Assert(actual.EndColumn, check.Equals, e.EndColumn)
+		c.Assert(actual.Value, check.Equals, e.Value)
+		c.Assert(actual.Code, check.Equals, e.Code)
+	}
+}
+
+func (t *FileTest) TestGenerateEndTransmitter(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	transmitter := instance.Transmitter.(*records.TRecord)
+	endTransmitter := instance.EndTransmitter.(*records.FRecord)
+	transmitter.TotalNumberPayees = 5
+	endTransmitter.NumberPayerRecords = 3
+	endTransmitter.TotalNumberPayees = 1
+
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 3)
+	c.Assert(report.Errors[0].Error(), check.Equals, "T record #1 TotalNumberPayees (columns 296-303): has unexpected total of TotalNumberPayees (expected 2, got 5)")
+	c.Assert(report.Errors[1].Error(), check.Equals, "F record #7 NumberPayerRecords (columns 2-9): has unexpected total of NumberPayerRecords (expected 1, got 3)")
+	c.Assert(report.Errors[2].Error(), check.Equals, "F record #7 TotalNumberPayees (columns 50-57): has unexpected total of TotalNumberPayees (expected 2, got 1)")
+
+	c.Assert(f.GenerateEndTransmitter(), check.IsNil)
+	c.Assert(f.Validate(), check.IsNil)
+	c.Assert(string(f.Ascii()), check.Equals, string(t.oneTransactionAscii))
+}
+
+func (t *FileTest) TestCreateFileWithoutTotals(c *check.C) {
+	var data map[string]interface{}
+	c.Assert(json.Unmarshal(t.oneTransactionJson, &data), check.IsNil)
+	transmitter := data["transmitter"].(map[string]interface{})
+	delete(transmitter, "total_number_of_payees")
+	delete(transmitter, "record_sequence_number")
+	data["end_transmitter"] = map[string]interface{}{"record_type": "F"}
+	buf, err := json.Marshal(data)
+	c.Assert(err, check.IsNil)
+
+	f, err := CreateFile(buf)
+	c.Assert(err, check.IsNil)
+	c.Assert(f.Validate(), check.NotNil)
+
+	c.Assert(f.GenerateEndTransmitter(), check.IsNil)
+	f.Renumber()
+	c.Assert(f.Validate(), check.IsNil)
+	c.Assert(string(f.Ascii()), check.Equals, string(t.oneTransactionAscii))
+}
+
+func (t *FileTest) TestCreateFileWithWrongTotals(c *check.C) {
+	var data map[string]interface{}
+	c.Assert(json.Unmarshal(t.oneTransactionJson, &data), check.IsNil)
+	data["transmitter"].(map[string]interface{})["total_number_of_payees"] = 3
+	buf, err := json.Marshal(data)
+	c.Assert(err, check.IsNil)
+
+	f, err := CreateFile(buf)
+	c.Assert(err, check.IsNil)
+	err = f.Validate()
+	c.Assert(err, check.NotNil)
+	c.Assert(err.Error(), check.Equals, "T record #1 TotalNumberPayees (columns 296-303): has unexpected total of TotalNumberPayees (expected 2, got 3)")
+}
+
+func (t *FileTest) TestGenerateStates(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	person := instance.PaymentPersons[0]
+	person.States = nil
+	f.Renumber()
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	c.Assert(err.Error(), check.Equals, "A record #2: should exist state totals “K” record for combined federal state code 1")
+
+	c.Assert(f.GenerateStates(), check.IsNil)
+	c.Assert(person.States, check.HasLen, 1)
+	c.Assert(f.Validate(), check.IsNil)
+	c.Assert(string(f.Ascii()), check.Equals, string(t.oneTransactionAscii))
+
+	// payees of different states are totaled in separate “K” records
+	payee := person.Payees[0].(*records.BRecord)
+	buf, err := json.Marshal(payee)
+	c.Assert(err, check.IsNil)
+	buf = bytes.Replace(buf, []byte(`"combined_federal_state_code":1`), []byte(`"combined_federal_state_code":6`), 1)
+	c.Assert(json.Unmarshal(buf, payee), check.IsNil)
+	c.Assert(payee.CombinedFSCode(), check.Equals, 6)
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors[0].Code, check.Equals, utils.CodeUnexpectedTotal)
+	c.Assert(report.Errors[len(report.Errors)-1].Code, check.Equals, utils.CodeMissingState)
+
+	c.Assert(f.GenerateStates(), check.IsNil)
+	c.Assert(person.States, check.HasLen, 2)
+	first := person.States[0].(*records.KRecord)
+	second := person.States[1].(*records.KRecord)
+	c.Assert(first.CombinedFederalStateCode, check.Equals, 1)
+	c.Assert(first.NumberPayees, check.Equals, 1)
+	c.Assert(first.StateIncomeTaxWithheldTotal, check.Equals, 0)
+	c.Assert(first.LocalIncomeTaxWithheldTotal, check.Equals, 1)
+	c.Assert(second.CombinedFederalStateCode, check.Equals, 6)
+	c.Assert(second.ControlTotal1, check.Equals, 100)
+	c.Assert(second.StateIncomeTaxWithheldTotal, check.Equals, 4)
+	c.Assert(second.RecordSequenceNumber, check.Equals, 7)
+	c.Assert(instance.EndTransmitter.SequenceNumber(), check.Equals, 8)
+	c.Assert(f.Validate(), check.IsNil)
+}
+
+func (t *FileTest) TestValidateStatesWithoutFilingProgram(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	instance.PaymentPersons[0].Payer.(*records.ARecord).CombinedFSFilingProgram = ""
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 1)
+	c.Assert(report.Errors[0].Code, check.Equals, utils.CodeFSFilingProgram)
+	c.Assert(report.Errors[0].FieldName, check.Equals, "CombinedFSFilingProgram")
+}
+
+func (t *FileTest) TestGenerateStatesWithoutFilingProgram(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	instance.PaymentPersons[0].Payer.(*records.ARecord).CombinedFSFilingProgram = ""
+	c.Assert(f.GenerateStates(), check.IsNil)
+	c.Assert(f.GenerateEndTransmitter(), check.IsNil)
+	f.Renumber()
+	c.Assert(instance.PaymentPersons[0].States, check.HasLen, 0)
+	c.Assert(f.Validate(), check.IsNil)
+
+	// the stream reader reads the generated file without problems
+	_, problems := readAll(c, NewReader(bytes.NewReader(f.Ascii())))
+	for _, err := range problems {
+		c.Assert(err, check.IsNil)
+	}
+}
+
+func (t *FileTest) TestValidatePaymentYears(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	instance.PaymentPersons[0].Payer.(*records.ARecord).PaymentYear = 2018
+	instance.PaymentPersons[0].Payees[1].(*records.BRecord).PaymentYear = 2016
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 2)
+	c.Assert(report.Errors[0].Error(), check.Equals, "A record #2 PaymentYear (columns 2-5): is different from payment year 2017 of “T” record")
+	c.Assert(report.Errors[1].Error(), check.Equals, "B record #4 PaymentYear (columns 2-5): is different from payment year 2017 of “T” record")
+	c.Assert(report.Errors[1].Code, check.Equals, utils.CodeMismatchedField)
+}
+
+func (t *FileTest) TestValidatePriorYearData(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	transmitter := f.(*fileInstance).Transmitter.(*records.TRecord)
+	transmitter.PriorYearDataIndicator = ""
+	c.Assert(f.Validate(), check.IsNil)
+
+	f.SetValidation(&ValidateOpts{TaxYear: 2020})
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 1)
+	c.Assert(report.Errors[0].FieldName, check.Equals, "PriorYearDataIndicator")
+	c.Assert(report.Errors[0].Code, check.Equals, utils.CodePriorYearData)
+
+	f.SetValidation(&ValidateOpts{TaxYear: 2017})
+	c.Assert(f.Validate(), check.IsNil)
+	transmitter.PriorYearDataIndicator = "P"
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	c.Assert(err.Error(), check.Equals, "T record #1 PriorYearDataIndicator (columns 6-6): should be blank when reporting current year data")
+
+	f.SetValidation(nil)
+	c.Assert(f.Validate(), check.IsNil)
+}
+
+func (t *FileTest) TestValidateNonemployeeCompensation(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	// nonemployee compensation is reported by 1099-MISC before 2020
+	c.Assert(f.Validate(), check.IsNil)
+
+	instance := f.(*fileInstance)
+	instance.Transmitter.(*records.TRecord).PaymentYear = 2020
+	instance.PaymentPersons[0].Payer.(*records.ARecord).PaymentYear = 2020
+	for _, payee := range instance.PaymentPersons[0].Payees {
+		payee.(*records.BRecord).PaymentYear = 2020
+	}
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 1)
+	c.Assert(report.Errors[0].Error(), check.Equals, "A record #2 AmountCodes (columns 28-43): should not include amount code 7 of 1099-MISC, nonemployee compensation is reported by 1099-NEC since payment year 2020")
+	c.Assert(report.Errors[0].Code, check.Equals, utils.CodeNonemployeeComp)
+}
+
+func (t *FileTest) TestValidateAmountCodes(c *check.C) {
+	f, err := CreateFile(t.oneTransactionJson)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	instance.PaymentPersons[0].Payer.(*records.ARecord).AmountCodes = "1234678ABCDE"
+	err = f.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 2)
+	c.Assert(report.Errors[0].Error(), check.Equals, "B record #3 PaymentAmount5 (columns 103-114): should be zero for amount code 5 that isn’t in amount codes of “A” record")
+	c.Assert(report.Errors[1].SequenceNumber, check.Equals, 4)
+	c.Assert(report.Errors[1].Code, check.Equals, utils.CodeUnreportedAmount)
+}
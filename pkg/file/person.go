@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
 
 	"github.com/moov-io/irs/pkg/config"
 	"github.com/moov-io/irs/pkg/records"
@@ -47,35 +49,51 @@ func (p *paymentPerson) Ascii() []byte {
 }
 
 // Validate performs some checks on the record and returns an error if not Validated
+// The returned error is a validation report that collects all problems of the records
 func (p *paymentPerson) Validate() error {
 	if p.Payer == nil || p.EndPayer == nil {
 		return utils.ErrInvalidFile
 	}
 
-	err := p.Payer.Validate()
-	if err != nil {
-		return err
-	}
+	report := &utils.ValidationReport{}
+	report.Add(p.Payer, p.Payer.Validate())
 
 	for _, payee := range p.Payees {
-		err = payee.Validate()
-		if err != nil {
-			return err
-		}
+		report.Add(payee, payee.Validate())
 	}
 
-	err = p.EndPayer.Validate()
+	report.Add(p.EndPayer, p.EndPayer.Validate())
+	report.Add(p.EndPayer, p.validateEndPayer())
+
+	for _, state := range p.States {
+		report.Add(state, state.Validate())
+	}
+	report.Add(nil, p.validateStates())
+
+	return report.Err()
+}
+
+// GenerateEndPayer replaces end payer “C” record with totals accumulated from payee “B” records
+func (p *paymentPerson) GenerateEndPayer() error {
+	endPayer, err := p.integrateEndPayer()
 	if err != nil {
 		return err
 	}
+	p.EndPayer = endPayer
+	return nil
+}
 
-	for _, state := range p.States {
-		err = state.Validate()
-		if err != nil {
-			return err
-		}
+// GenerateStates replaces state totals “K” records with totals accumulated from payee “B” records
+// that have the same CF/SF code
+func (p *paymentPerson) GenerateStates() error {
+	states, err := p.integrateStates()
+	if err != nil {
+		return err
+	}
+	p.States = make([]records.Record, 0, len(states))
+	for _, state := range states {
+		p.States = append(p.States, state)
 	}
-
 	return nil
 }
 
@@ -87,15 +105,20 @@ func (p *paymentPerson) SequenceNumber() int {
 	return p.Payer.SequenceNumber()
 }
 
-// SequenceNumber set sequence number of the record
-func (p *paymentPerson) SetSequenceNumber(int) {}
+// SetSequenceNumber set sequence numbers of all records of the person, starting with the payer “A” record
+func (p *paymentPerson) SetSequenceNumber(number int) {
+	for _, record := range p.records() {
+		record.SetSequenceNumber(number)
+		number++
+	}
+}
 
 // Parse attempts to parse with raw data.
 func (p *paymentPerson) Parse(buf []byte) (int, error) {
 	bufSize := len(buf)
 	readPtr := 0
 
-	if string(buf[readPtr]) != config.ARecordType || bufSize < config.RecordLength {
+	if bufSize < config.RecordLength || string(buf[readPtr]) != config.ARecordType {
 		return readPtr, utils.ErrInvalidAscii
 	}
 
@@ -118,7 +141,7 @@ func (p *paymentPerson) Parse(buf []byte) (int, error) {
 	}
 
 	p.Payees = []records.Record{}
-	for string(buf[readPtr]) == config.BRecordType {
+	for readPtr < bufSize && string(buf[readPtr]) == config.BRecordType {
 		if bufSize < readPtr+config.RecordLength {
 			return readPtr, utils.ErrInvalidAscii
 		}
@@ -132,7 +155,10 @@ func (p *paymentPerson) Parse(buf []byte) (int, error) {
 		p.Payees = append(p.Payees, newPayee)
 	}
 
-	if string(buf[readPtr]) == config.CRecordType || bufSize < readPtr+config.RecordLength {
+	if readPtr < bufSize && string(buf[readPtr]) == config.CRecordType {
+		if bufSize < readPtr+config.RecordLength {
+			return readPtr, utils.ErrInvalidAscii
+		}
 		if p.EndPayer == nil {
 			p.EndPayer = records.NewCRecord()
 		}
@@ -144,7 +170,7 @@ func (p *paymentPerson) Parse(buf []byte) (int, error) {
 	}
 
 	p.States = []records.Record{}
-	for string(buf[readPtr]) == config.KRecordType {
+	for readPtr < bufSize && string(buf[readPtr]) == config.KRecordType {
 		if bufSize < readPtr+config.RecordLength {
 			return readPtr, utils.ErrInvalidAscii
 		}
@@ -239,3 +265,251 @@ func (p *paymentPerson) UnmarshalJSON(data []byte) error {
 
 	return nil
 }
+
+// records returns all records of the person in file order
+func (p *paymentPerson) records() []records.Record {
+	var list []records.Record
+	if p.Payer != nil {
+		list = append(list, p.Payer)
+	}
+	list = append(list, p.Payees...)
+	if p.EndPayer != nil {
+		list = append(list, p.EndPayer)
+	}
+	return append(list, p.States...)
+}
+
+// payerTotals accumulates totals of payee “B” records of a payer, for end of payer “C” and state totals “K” records
+type payerTotals struct {
+	endPayer *records.CRecord
+	states   map[int]*records.KRecord
+	// state totals are accumulated only for payers participating in the CF/SF Program
+	participating bool
+}
+
+// newPayerTotals returns totals of the payer “A” record
+func newPayerTotals(payer records.Record) *payerTotals {
+	aRecord, ok := payer.(*records.ARecord)
+	return &payerTotals{
+		endPayer:      &records.CRecord{RecordType: config.CRecordType},
+		states:        make(map[int]*records.KRecord),
+		participating: ok && aRecord.CombinedFSFilingProgram == config.FSFilingProgramApproved,
+	}
+}
+
+// add accumulates payment amounts of the payee “B” record into totals of the payer and the CF/SF code of the payee
+func (t *payerTotals) add(payee records.Record) error {
+	bRecord, ok := payee.(*records.BRecord)
+	if !ok {
+		return fmt.Errorf("unexpected Payee to be a BRecord, but got %T", payee)
+	}
+
+	t.endPayer.NumberPayees++
+	if err := accumulateTotals(reflect.ValueOf(t.endPayer).Elem(), payee); err != nil {
+		return err
+	}
+
+	code := bRecord.CombinedFSCode()
+	if code == 0 || !t.participating {
+		return nil
+	}
+	state, ok := t.states[code]
+	if !ok {
+		state = &records.KRecord{
+			RecordType:               config.KRecordType,
+			CombinedFederalStateCode: code,
+		}
+		t.states[code] = state
+	}
+
+	state.NumberPayees++
+	state.StateIncomeTaxWithheldTotal += bRecord.StateIncomeTaxWithheld()
+	state.LocalIncomeTaxWithheldTotal += bRecord.LocalIncomeTaxWithheld()
+	return accumulateTotals(reflect.ValueOf(state).Elem(), payee)
+}
+
+// stateRecords returns state totals “K” records in ascending order of the CF/SF code
+func (t *payerTotals) stateRecords() []*records.KRecord {
+	codes := make([]int, 0, len(t.states))
+	for code := range t.states {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	list := make([]*records.KRecord, len(codes))
+	for index, code := range codes {
+		list[index] = t.states[code]
+	}
+	return list
+}
+
+// integrateTotals returns totals accumulated from payee “B” records
+func (p *paymentPerson) integrateTotals() (*payerTotals, error) {
+	totals := newPayerTotals(p.Payer)
+	for _, payee := range p.Payees {
+		if err := totals.add(payee); err != nil {
+			return nil, err
+		}
+	}
+	return totals, nil
+}
+
+// integrateEndPayer returns end payer “C” record that accumulated from payee “B” records
+func (p *paymentPerson) integrateEndPayer() (*records.CRecord, error) {
+	totals, err := p.integrateTotals()
+	if err != nil {
+		return nil, err
+	}
+	endPayer := totals.endPayer
+	if p.EndPayer != nil {
+		endPayer.RecordSequenceNumber = p.EndPayer.SequenceNumber()
+	}
+	return endPayer, nil
+}
+
+// validateEndPayer checks end payer “C” record with totals accumulated from payee “B” records
+func (p *paymentPerson) validateEndPayer() error {
+	expected, err := p.integrateEndPayer()
+	if err != nil {
+		return err
+	}
+	return checkEndPayer(expected, p.EndPayer)
+}
+
+// checkEndPayer checks number of payees and control totals of end payer “C” record with the expected record
+func checkEndPayer(expected *records.CRecord, record records.Record) error {
+	endPayer, ok := record.(*records.CRecord)
+	if !ok {
+		return fmt.Errorf("unexpected EndPayer to be a CRecord, but got %T", record)
+	}
+	return compareTotals(reflect.ValueOf(expected).Elem(), reflect.ValueOf(endPayer).Elem(), config.CRecordLayout)
+}
+
+// integrateStates returns state totals “K” records that accumulated from payee “B” records,
+// one for each CF/SF code of payees in ascending order of the code
+func (p *paymentPerson) integrateStates() ([]*records.KRecord, error) {
+	totals, err := p.integrateTotals()
+	if err != nil {
+		return nil, err
+	}
+	return totals.stateRecords(), nil
+}
+
+// validateStates checks state totals “K” records with totals accumulated from payee “B” records,
+// and checks that the payer participates in the CF/SF Program if there are “K” records
+func (p *paymentPerson) validateStates() error {
+	expected, err := p.integrateStates()
+	if err != nil {
+		return err
+	}
+	return checkStates(p.Payer, p.States, expected)
+}
+
+// checkStates checks state totals “K” records of the payer with the expected records,
+// and checks that the payer participates in the CF/SF Program if there are “K” records
+func checkStates(record records.Record, states []records.Record, expected []*records.KRecord) error {
+	payer, ok := record.(*records.ARecord)
+	if !ok {
+		return fmt.Errorf("unexpected Payer to be an ARecord, but got %T", record)
+	}
+
+	expectedStates := make(map[int]*records.KRecord)
+	for _, state := range expected {
+		expectedStates[state.CombinedFederalStateCode] = state
+	}
+
+	report := &utils.ValidationReport{}
+	participating := payer.CombinedFSFilingProgram == config.FSFilingProgramApproved
+	if len(states) > 0 && !participating {
+		// totals of states aren't accumulated for payers that don't participate
+		err := utils.NewFieldError("CombinedFSFilingProgram", config.ARecordLayout["CombinedFSFilingProgram"], payer.CombinedFSFilingProgram, utils.ErrFSFilingProgram)
+		report.Add(payer, err)
+		return report.Err()
+	}
+
+	reported := make(map[int]bool)
+	for _, record := range states {
+		state, ok := record.(*records.KRecord)
+		if !ok {
+			return fmt.Errorf("unexpected State to be a KRecord, but got %T", record)
+		}
+
+		code := state.CombinedFederalStateCode
+		expectedState, ok := expectedStates[code]
+		if !ok || reported[code] {
+			// no payees of the CF/SF code, or duplicated “K” record
+			expectedState = &records.KRecord{}
+		}
+		reported[code] = true
+
+		report.Add(state, compareTotals(reflect.ValueOf(expectedState).Elem(), reflect.ValueOf(state).Elem(), config.KRecordLayout))
+		report.Add(state, compareWithheldTotals(expectedState, state))
+	}
+
+	if participating {
+		for _, state := range expected {
+			if !reported[state.CombinedFederalStateCode] {
+				report.Add(payer, utils.NewErrMissingStateRecord(state.CombinedFederalStateCode))
+			}
+		}
+	}
+
+	return report.Err()
+}
+
+// accumulateTotals adds payment amounts of payee “B” record into control totals of “C” or “K” record
+func accumulateTotals(totals reflect.Value, payee records.Record) error {
+	bRecord, ok := payee.(*records.BRecord)
+	if !ok {
+		return fmt.Errorf("unexpected Payee to be a BRecord, but got %T", payee)
+	}
+
+	amounts := reflect.ValueOf(bRecord).Elem()
+	for _, code := range config.PaymentAmountCodes {
+		total := totals.FieldByName("ControlTotal" + code)
+		amount := amounts.FieldByName("PaymentAmount" + code)
+		if !total.IsValid() || !amount.IsValid() {
+			return utils.ErrValidField
+		}
+		total.SetInt(total.Int() + amount.Int())
+	}
+
+	return nil
+}
+
+// compareTotals compares number of payees and control totals of “C” or “K” records
+func compareTotals(expected, actual reflect.Value, layout map[string]config.SpecField) error {
+	names := []string{"NumberPayees"}
+	for _, code := range config.PaymentAmountCodes {
+		names = append(names, "ControlTotal"+code)
+	}
+
+	report := &utils.ValidationReport{}
+	for _, name := range names {
+		expectedValue := expected.FieldByName(name)
+		actualValue := actual.FieldByName(name)
+		if !expectedValue.IsValid() || !actualValue.IsValid() {
+			return utils.ErrValidField
+		}
+		if expectedValue.Int() != actualValue.Int() {
+			err := utils.NewErrUnexpectedTotal(name, int(expectedValue.Int()), int(actualValue.Int()))
+			report.Add(nil, utils.NewFieldError(name, layout[name], actualValue.Int(), err))
+		}
+	}
+
+	return report.Err()
+}
+
+// compareWithheldTotals compares state and local income tax withheld totals of “K” records,
+// the totals may be blank filled since they are for the convenience of filers
+func compareWithheldTotals(expected, actual *records.KRecord) error {
+	report := &utils.ValidationReport{}
+	if actual.StateIncomeTaxWithheldTotal != 0 && actual.StateIncomeTaxWithheldTotal != expected.StateIncomeTaxWithheldTotal {
+		err := utils.NewErrUnexpectedTotal("StateIncomeTaxWithheldTotal", expected.StateIncomeTaxWithheldTotal, actual.StateIncomeTaxWithheldTotal)
+		report.Add(nil, utils.NewFieldError("StateIncomeTaxWithheldTotal", config.KRecordLayout["StateIncomeTaxWithheldTotal"], actual.StateIncomeTaxWithheldTotal, err))
+	}
+	if actual.LocalIncomeTaxWithheldTotal != 0 && actual.LocalIncomeTaxWithheldTotal != expected.LocalIncomeTaxWithheldTotal {
+		err := utils.NewErrUnexpectedTotal("LocalIncomeTaxWithheldTotal", expected.LocalIncomeTaxWithheldTotal, actual.LocalIncomeTaxWithheldTotal)
+		report.Add(nil, utils.NewFieldError("LocalIncomeTaxWithheldTotal", config.KRecordLayout["LocalIncomeTaxWithheldTotal"], actual.LocalIncomeTaxWithheldTotal, err))
+	}
+	return report.Err()
+}
@@ -0,0 +1,190 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package file
+
+import (
+	"strings"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+// fileRule checks relationships between records of the file
+type fileRule func(f *fileInstance) error
+
+// fileRules are relationships between records required by Publication 1220,
+// in the order of problems in the validation report
+var fileRules = []fileRule{
+	(*fileInstance).validateSequenceNumber,
+	(*fileInstance).validateTransmissionTotals,
+	(*fileInstance).validatePaymentYears,
+	(*fileInstance).validatePriorYearData,
+	(*fileInstance).validateNonemployeeCompensation,
+	(*fileInstance).validateAmountCodes,
+	(*fileInstance).validateCorrectedReturns,
+}
+
+// validatePaymentYears checks that payment years of payer “A” and payee “B” records are the payment year of transmitter “T” record
+func (f *fileInstance) validatePaymentYears() error {
+	transmitter, ok := f.Transmitter.(*records.TRecord)
+	if !ok {
+		return nil
+	}
+
+	report := &utils.ValidationReport{}
+	for _, person := range f.PaymentPersons {
+		report.Add(nil, checkPaymentYear(transmitter, person.Payer))
+		for _, payee := range person.Payees {
+			report.Add(nil, checkPaymentYear(transmitter, payee))
+		}
+	}
+
+	return report.Err()
+}
+
+// checkPaymentYear checks that payment year of payer “A” or payee “B” record is the payment year of transmitter “T” record
+func checkPaymentYear(transmitter *records.TRecord, record records.Record) error {
+	var paymentYear int
+	switch r := record.(type) {
+	case *records.ARecord:
+		paymentYear = r.PaymentYear
+	case *records.BRecord:
+		paymentYear = r.PaymentYear
+	default:
+		return nil
+	}
+	if paymentYear == transmitter.PaymentYear {
+		return nil
+	}
+
+	report := &utils.ValidationReport{}
+	err := utils.NewErrMismatchedField("payment year", config.TRecordType, transmitter.PaymentYear)
+	report.Add(record, utils.NewFieldError("PaymentYear", config.RecordLayouts[record.Type()]["PaymentYear"], paymentYear, err))
+	return report.Err()
+}
+
+// validatePriorYearData checks prior year data indicator of transmitter “T” record with its payment year
+func (f *fileInstance) validatePriorYearData() error {
+	transmitter, ok := f.Transmitter.(*records.TRecord)
+	if !ok {
+		return nil
+	}
+	return checkPriorYearData(transmitter, f.validateOpts)
+}
+
+// checkPriorYearData checks prior year data indicator of transmitter “T” record with its payment year,
+// when the tax year of the filing season is given by validation options
+func checkPriorYearData(transmitter *records.TRecord, opts *ValidateOpts) error {
+	if opts == nil || opts.TaxYear == 0 || transmitter.PaymentYear == 0 {
+		return nil
+	}
+
+	var err error
+	priorYear := transmitter.PaymentYear < opts.TaxYear
+	if priorYear && transmitter.PriorYearDataIndicator != config.PriorYearDataIndicator {
+		err = utils.ErrPriorYearData
+	} else if !priorYear && len(transmitter.PriorYearDataIndicator) > 0 {
+		err = utils.ErrCurrentYearData
+	}
+	if err == nil {
+		return nil
+	}
+
+	report := &utils.ValidationReport{}
+	spec := config.TRecordLayout["PriorYearDataIndicator"]
+	report.Add(transmitter, utils.NewFieldError("PriorYearDataIndicator", spec, transmitter.PriorYearDataIndicator, err))
+	return report.Err()
+}
+
+// validateNonemployeeCompensation checks that 1099-MISC payer “A” records don't report nonemployee compensation
+// of payment years reporting it by 1099-NEC
+func (f *fileInstance) validateNonemployeeCompensation() error {
+	report := &utils.ValidationReport{}
+	for _, person := range f.PaymentPersons {
+		if payer, ok := person.Payer.(*records.ARecord); ok {
+			report.Add(nil, checkNonemployeeCompensation(payer))
+		}
+	}
+	return report.Err()
+}
+
+// checkNonemployeeCompensation checks that amount codes of 1099-MISC payer “A” record don't include nonemployee compensation
+// since payment year 2020, when it's reported by 1099-NEC
+func checkNonemployeeCompensation(payer *records.ARecord) error {
+	if config.TypeOfReturns[payer.TypeOfReturn] != config.Sub1099MiscType || payer.PaymentYear < config.NecPaymentYear ||
+		!strings.Contains(payer.AmountCodes, config.MiscNecAmountCode) {
+		return nil
+	}
+
+	report := &utils.ValidationReport{}
+	spec := config.ARecordLayout["AmountCodes"]
+	report.Add(payer, utils.NewFieldError("AmountCodes", spec, payer.AmountCodes, utils.ErrNonemployeeCompensation))
+	return report.Err()
+}
+
+// validateAmountCodes checks that payee “B” records have non-zero payment amounts only for amount codes of their payer “A” record
+func (f *fileInstance) validateAmountCodes() error {
+	report := &utils.ValidationReport{}
+	for _, person := range f.PaymentPersons {
+		payer, ok := person.Payer.(*records.ARecord)
+		if !ok {
+			continue
+		}
+
+		for _, record := range person.Payees {
+			if payee, ok := record.(*records.BRecord); ok {
+				report.Add(nil, checkAmountCodes(payer, payee))
+			}
+		}
+	}
+	return report.Err()
+}
+
+// checkAmountCodes checks that payee “B” record has non-zero payment amounts only for amount codes of its payer “A” record
+func checkAmountCodes(payer *records.ARecord, payee *records.BRecord) error {
+	report := &utils.ValidationReport{}
+	amounts := payee.PaymentAmounts()
+	for _, code := range config.PaymentAmountCodes {
+		if amounts[code] == 0 || strings.Contains(payer.AmountCodes, code) {
+			continue
+		}
+		name := "PaymentAmount" + code
+		report.Add(payee, utils.NewFieldError(name, config.BRecordLayout[name], amounts[code], utils.NewErrUnreportedAmount(code)))
+	}
+	return report.Err()
+}
+
+// validateCorrectedReturns checks that payee “B” records of each payer “A” record have the same corrected return indicator,
+// since original, “G” coded and “C” coded records must be reported using separate payer “A” records
+func (f *fileInstance) validateCorrectedReturns() error {
+	report := &utils.ValidationReport{}
+	for _, person := range f.PaymentPersons {
+		var first *records.BRecord
+		for _, record := range person.Payees {
+			payee, ok := record.(*records.BRecord)
+			if !ok {
+				continue
+			}
+			if first == nil {
+				first = payee
+				continue
+			}
+			report.Add(nil, checkCorrectedReturn(first, payee))
+		}
+	}
+	return report.Err()
+}
+
+// checkCorrectedReturn checks that payee “B” record has the corrected return indicator of the first payee of its payer
+func checkCorrectedReturn(first, payee *records.BRecord) error {
+	if payee.CorrectedReturnIndicator == first.CorrectedReturnIndicator {
+		return nil
+	}
+	report := &utils.ValidationReport{}
+	spec := config.BRecordLayout["CorrectedReturnIndicator"]
+	report.Add(payee, utils.NewFieldError("CorrectedReturnIndicator", spec, payee.CorrectedReturnIndicator, utils.ErrMixedCorrectedReturns))
+	return report.Err()
+}
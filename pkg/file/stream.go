@@ -0,0 +1,318 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package file
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+// record types that may follow each record type, the empty type is the start of the file
+var nextRecordTypes = map[string][]string{
+	"":                 {config.TRecordType},
+	config.TRecordType: {config.ARecordType, config.FRecordType},
+	config.ARecordType: {config.BRecordType, config.CRecordType},
+	config.BRecordType: {config.BRecordType, config.CRecordType},
+	config.CRecordType: {config.KRecordType, config.ARecordType, config.FRecordType},
+	config.KRecordType: {config.KRecordType, config.ARecordType, config.FRecordType},
+}
+
+// record types that may be written after each record type, since other records are generated by writers
+var nextWrittenRecordTypes = map[string][]string{
+	"":                 {config.TRecordType},
+	config.TRecordType: {config.ARecordType},
+	config.ARecordType: {config.BRecordType, config.ARecordType},
+	config.BRecordType: {config.BRecordType, config.ARecordType},
+}
+
+// Reader reads records of fire ascii one at a time, without keeping records in memory.
+//
+// Records are validated while reading, as Validate of a file does: problems of each record, such as unexpected sequence
+// numbers or payment amounts of amount codes that aren't reported by the payer, are returned with the record.
+// Totals of end of payer “C” records are checked with payee “B” records read before,
+// and state totals “K” records of a payer are checked when reading the record following them.
+type Reader struct {
+	r    *bufio.Reader
+	buf  []byte
+	last string
+	// number of records read
+	number int
+	// sequence numbers of records read
+	sequenceNumbers sequenceNumbers
+	validateOpts    *ValidateOpts
+
+	transmitter  *records.TRecord
+	numberPayers int
+	numberPayees int
+
+	payer        *records.ARecord
+	typeOfReturn string
+	firstPayee   *records.BRecord
+	totals       *payerTotals
+	states       []records.Record
+}
+
+// NewReader returns a reader of records of fire ascii read from r
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		r:   bufio.NewReader(r),
+		buf: make([]byte, config.RecordLength),
+	}
+}
+
+// SetValidation sets options of validating records read
+func (r *Reader) SetValidation(opts *ValidateOpts) {
+	r.validateOpts = opts
+}
+
+// Read returns the next record, or io.EOF after the end of transmission “F” record.
+//
+// When the record has problems, the record is returned with a *utils.ValidationReport describing the problems,
+// and reading can continue. Other errors, such as records out of order, stop reading.
+func (r *Reader) Read() (records.Record, error) {
+	if r.last == config.FRecordType {
+		return nil, io.EOF
+	}
+	if _, err := io.ReadFull(r.r, r.buf); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, fmt.Errorf("missing records after record #%d: %w", r.number, utils.ErrInvalidAscii)
+		}
+		return nil, err
+	}
+
+	recordType := string(r.buf[:1])
+	if !containsString(nextRecordTypes[r.last], recordType) {
+		return nil, fmt.Errorf("unexpected record type %q of record #%d: %w", recordType, r.number+1, utils.ErrInvalidAscii)
+	}
+
+	var record records.Record
+	switch recordType {
+	case config.TRecordType:
+		record = records.NewTRecord()
+	case config.ARecordType:
+		record = records.NewARecord()
+	case config.BRecordType:
+		record = records.NewBRecord(r.typeOfReturn)
+	case config.CRecordType:
+		record = records.NewCRecord()
+	case config.KRecordType:
+		record = records.NewKRecord()
+	case config.FRecordType:
+		record = records.NewFRecord()
+	}
+	if err := record.Parse(r.buf); err != nil {
+		return nil, err
+	}
+	r.last = recordType
+	r.number++
+
+	report := &utils.ValidationReport{}
+	report.Add(record, record.Validate())
+	report.Add(nil, r.sequenceNumbers.check(record, r.number))
+
+	switch rec := record.(type) {
+	case *records.TRecord:
+		r.transmitter = rec
+		report.Add(nil, checkPriorYearData(rec, r.validateOpts))
+	case *records.ARecord:
+		report.Add(nil, r.endPayer())
+		r.payer, r.typeOfReturn = rec, config.TypeOfReturns[rec.TypeOfReturn]
+		r.firstPayee, r.totals, r.states = nil, newPayerTotals(rec), nil
+		r.numberPayers++
+		report.Add(nil, checkPaymentYear(r.transmitter, rec))
+		report.Add(nil, checkNonemployeeCompensation(rec))
+	case *records.BRecord:
+		if err := r.totals.add(rec); err != nil {
+			return nil, err
+		}
+		r.numberPayees++
+		report.Add(nil, checkPaymentYear(r.transmitter, rec))
+		report.Add(nil, checkAmountCodes(r.payer, rec))
+		if r.firstPayee == nil {
+			r.firstPayee = rec
+		} else {
+			report.Add(nil, checkCorrectedReturn(r.firstPayee, rec))
+		}
+	case *records.CRecord:
+		report.Add(rec, checkEndPayer(r.totals.endPayer, rec))
+	case *records.KRecord:
+		r.states = append(r.states, rec)
+	case *records.FRecord:
+		report.Add(nil, r.endPayer())
+		report.Add(nil, checkTransmissionTotals(r.transmitter, rec, r.numberPayers, r.numberPayees))
+	}
+
+	return record, report.Err()
+}
+
+// endPayer checks state totals “K” records of the payer that was read
+func (r *Reader) endPayer() error {
+	if r.payer == nil {
+		return nil
+	}
+	return checkStates(r.payer, r.states, r.totals.stateRecords())
+}
+
+// Writer writes records of fire ascii one at a time, without keeping records in memory.
+//
+// Transmitter “T”, payer “A” and payee “B” records are written in file order, and sequence numbers of records are set
+// while writing. End of payer “C” and state totals “K” records are generated from payee “B” records when the next payer
+// is written or the writer is closed, and end of transmission “F” record is written by closing the writer.
+type Writer struct {
+	w *bufio.Writer
+	// underlying writer and offset of the transmitter, to update total number of payees of the transmitter
+	seeker io.WriteSeeker
+	start  int64
+	last   string
+	// number of records written
+	number int
+
+	transmitter  *records.TRecord
+	numberPayers int
+	numberPayees int
+
+	payer        *records.ARecord
+	typeOfReturn string
+	totals       *payerTotals
+}
+
+// NewWriter returns a writer of records of fire ascii written to w.
+//
+// Total number of payees of the transmitter “T” record can be updated by closing the writer only when w is an
+// io.WriteSeeker, such as *os.File. Otherwise the total should be set before writing the transmitter.
+func NewWriter(w io.Writer) *Writer {
+	writer := &Writer{w: bufio.NewWriter(w)}
+	if seeker, ok := w.(io.WriteSeeker); ok {
+		// some files can't seek, such as pipes
+		if offset, err := seeker.Seek(0, io.SeekCurrent); err == nil {
+			writer.seeker, writer.start = seeker, offset
+		}
+	}
+	return writer
+}
+
+// Write writes transmitter “T”, payer “A” or payee “B” record, setting its sequence number.
+// Writing a payer “A” record writes end of payer “C” and state totals “K” records of the previous payer.
+func (w *Writer) Write(record records.Record) error {
+	recordType := record.Type()
+	if recordType != config.TRecordType && recordType != config.ARecordType && recordType != config.BRecordType {
+		return fmt.Errorf("%s records are generated by the writer: %w", recordType, utils.ErrInvalidFile)
+	}
+	if !containsString(nextWrittenRecordTypes[w.last], recordType) {
+		return fmt.Errorf("unexpected %s record after record #%d: %w", recordType, w.number, utils.ErrInvalidFile)
+	}
+
+	switch rec := record.(type) {
+	case *records.TRecord:
+		w.transmitter = rec
+	case *records.ARecord:
+		if err := w.endPayer(); err != nil {
+			return err
+		}
+		w.payer, w.typeOfReturn, w.totals = rec, config.TypeOfReturns[rec.TypeOfReturn], newPayerTotals(rec)
+		w.numberPayers++
+	case *records.BRecord:
+		if rec.TypeOfReturn() != w.typeOfReturn {
+			return utils.NewErrMismatchedField("type of return", config.ARecordType, w.typeOfReturn)
+		}
+		if err := w.totals.add(rec); err != nil {
+			return err
+		}
+		w.numberPayees++
+	default:
+		return fmt.Errorf("unexpected %s record of type %T", recordType, record)
+	}
+
+	w.last = recordType
+	return w.write(record)
+}
+
+// Close writes end of payer “C” and state totals “K” records of the last payer and end of transmission “F” record,
+// updating total number of payees of the transmitter “T” record if possible, and flushes written records.
+// Close doesn't close the underlying writer.
+func (w *Writer) Close() error {
+	if w.last == config.FRecordType {
+		return errors.New("writer is already closed")
+	}
+	if w.transmitter == nil {
+		return fmt.Errorf("missing transmitter record: %w", utils.ErrInvalidFile)
+	}
+	if err := w.endPayer(); err != nil {
+		return err
+	}
+
+	endTransmitter := &records.FRecord{
+		RecordType:         config.FRecordType,
+		NumberPayerRecords: w.numberPayers,
+		TotalNumberPayees:  w.numberPayees,
+	}
+	w.last = config.FRecordType
+	if err := w.write(endTransmitter); err != nil {
+		return err
+	}
+	if err := w.w.Flush(); err != nil {
+		return err
+	}
+
+	if w.transmitter.TotalNumberPayees == w.numberPayees {
+		return nil
+	}
+	if w.seeker == nil {
+		return utils.NewErrUnexpectedTotal("TotalNumberPayees", w.numberPayees, w.transmitter.TotalNumberPayees)
+	}
+	w.transmitter.TotalNumberPayees = w.numberPayees
+	return w.rewriteTransmitter()
+}
+
+// endPayer writes end of payer “C” and state totals “K” records of the payer that was written
+func (w *Writer) endPayer() error {
+	if w.payer == nil {
+		return nil
+	}
+	if err := w.write(w.totals.endPayer); err != nil {
+		return err
+	}
+	for _, state := range w.totals.stateRecords() {
+		if err := w.write(state); err != nil {
+			return err
+		}
+	}
+	w.payer = nil
+	return nil
+}
+
+func (w *Writer) write(record records.Record) error {
+	w.number++
+	record.SetSequenceNumber(w.number)
+	_, err := w.w.Write(record.Ascii())
+	return err
+}
+
+// rewriteTransmitter writes the transmitter “T” record again at the start of written records
+func (w *Writer) rewriteTransmitter() error {
+	if _, err := w.seeker.Seek(w.start, io.SeekStart); err != nil {
+		return err
+	}
+	if _, err := w.seeker.Write(w.transmitter.Ascii()); err != nil {
+		return err
+	}
+	_, err := w.seeker.Seek(0, io.SeekEnd)
+	return err
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,151 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package file
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"gopkg.in/check.v1"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+// readAll reads all records with the reader, returning record types and validation reports of records
+func readAll(c *check.C, reader *Reader) (string, []error) {
+	types := ""
+	var problems []error
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return types, problems
+		}
+		if _, ok := err.(*utils.ValidationReport); err != nil && !ok {
+			c.Fatal(err)
+		}
+		types += record.Type()
+		problems = append(problems, err)
+	}
+}
+
+func (t *FileTest) TestReader(c *check.C) {
+	types, problems := readAll(c, NewReader(bytes.NewReader(t.oneTransactionAscii)))
+	c.Assert(types, check.Equals, "TABBCKF")
+	for _, err := range problems {
+		c.Assert(err, check.IsNil)
+	}
+}
+
+func (t *FileTest) TestReaderWithInvalidRecords(c *check.C) {
+	f, err := CreateFile(t.oneTransactionAscii)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	instance.PaymentPersons[0].EndPayer.(*records.CRecord).ControlTotal7++
+	instance.PaymentPersons[0].Payees[1].(*records.BRecord).PaymentYear = 2018
+	instance.EndTransmitter.(*records.FRecord).NumberPayerRecords = 2
+
+	types, problems := readAll(c, NewReader(bytes.NewReader(f.Ascii())))
+	c.Assert(types, check.Equals, "TABBCKF")
+	c.Assert(problems[3], check.NotNil)
+	c.Assert(problems[3].(*utils.ValidationReport).Errors[0].Code, check.Equals, utils.CodeMismatchedField)
+	c.Assert(problems[4], check.NotNil)
+	c.Assert(problems[4].(*utils.ValidationReport).Errors[0].FieldName, check.Equals, "ControlTotal7")
+	c.Assert(problems[5], check.IsNil)
+	c.Assert(problems[6], check.NotNil)
+	c.Assert(problems[6].(*utils.ValidationReport).Errors[0].FieldName, check.Equals, "NumberPayerRecords")
+
+	// the same problems are found by validating the file
+	c.Assert(f.Validate().(*utils.ValidationReport).Errors, check.HasLen, 3)
+}
+
+func (t *FileTest) TestReaderWithTaxYear(c *check.C) {
+	reader := NewReader(bytes.NewReader(t.oneTransactionAscii))
+	reader.SetValidation(&ValidateOpts{TaxYear: 2017})
+	types, problems := readAll(c, reader)
+	c.Assert(types, check.Equals, "TABBCKF")
+	c.Assert(problems[0], check.NotNil)
+	c.Assert(problems[0].(*utils.ValidationReport).Errors[0].FieldName, check.Equals, "PriorYearDataIndicator")
+	for _, err := range problems[1:] {
+		c.Assert(err, check.IsNil)
+	}
+}
+func (t *FileTest) TestReaderWithUnexpectedRecords(c *check.C) {
+	reader := NewReader(bytes.NewReader(t.oneTransactionAscii[:3*config.RecordLength]))
+	for i := 0; i < 3; i++ {
+		_, err := reader.Read()
+		c.Assert(err, check.IsNil)
+	}
+	_, err := reader.Read()
+	c.Assert(errors.Is(err, utils.ErrInvalidAscii), check.Equals, true)
+
+	_, err = NewReader(bytes.NewReader(t.oneTransactionAscii[config.RecordLength:])).Read()
+	c.Assert(errors.Is(err, utils.ErrInvalidAscii), check.Equals, true)
+}
+
+func (t *FileTest) TestWriter(c *check.C) {
+	f, err := CreateFile(t.oneTransactionAscii)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+
+	buf := &bytes.Buffer{}
+	writer := NewWriter(buf)
+	c.Assert(writer.Write(instance.Transmitter), check.IsNil)
+	for _, person := range instance.PaymentPersons {
+		c.Assert(writer.Write(person.Payer), check.IsNil)
+		for _, payee := range person.Payees {
+			c.Assert(writer.Write(payee), check.IsNil)
+		}
+	}
+	c.Assert(writer.Write(instance.PaymentPersons[0].EndPayer), check.NotNil)
+	c.Assert(writer.Close(), check.IsNil)
+	c.Assert(writer.Close(), check.NotNil)
+
+	c.Assert(f.GenerateEndPayers(), check.IsNil)
+	c.Assert(f.GenerateStates(), check.IsNil)
+	c.Assert(f.GenerateEndTransmitter(), check.IsNil)
+	c.Assert(buf.String(), check.Equals, string(f.Ascii()))
+
+	// the total number of payees of the transmitter can't be updated
+	instance.Transmitter.(*records.TRecord).TotalNumberPayees = 0
+	writer = NewWriter(&bytes.Buffer{})
+	c.Assert(writer.Write(instance.Transmitter), check.IsNil)
+	c.Assert(writer.Write(instance.PaymentPersons[0].Payees[0]), check.NotNil)
+	c.Assert(writer.Write(instance.PaymentPersons[0].Payer), check.IsNil)
+	c.Assert(writer.Write(instance.PaymentPersons[0].Payees[0]), check.IsNil)
+	c.Assert(writer.Close(), check.NotNil)
+}
+
+func (t *FileTest) TestWriterWithFile(c *check.C) {
+	f, err := CreateFile(t.oneTransactionAscii)
+	c.Assert(err, check.IsNil)
+	instance := f.(*fileInstance)
+	instance.Transmitter.(*records.TRecord).TotalNumberPayees = 0
+
+	out, err := ioutil.TempFile(c.MkDir(), "fire")
+	c.Assert(err, check.IsNil)
+	defer out.Close()
+	writer := NewWriter(out)
+	c.Assert(writer.Write(instance.Transmitter), check.IsNil)
+	person := instance.PaymentPersons[0]
+	c.Assert(writer.Write(person.Payer), check.IsNil)
+	for i := 0; i < 1000; i++ {
+		c.Assert(writer.Write(person.Payees[i%2]), check.IsNil)
+	}
+	c.Assert(writer.Close(), check.IsNil)
+
+	_, err = out.Seek(0, io.SeekStart)
+	c.Assert(err, check.IsNil)
+	reader := NewReader(out)
+	types, problems := readAll(c, reader)
+	c.Assert(types, check.HasLen, 1005)
+	for _, err := range problems {
+		c.Assert(err, check.IsNil)
+	}
+	c.Assert(reader.transmitter.TotalNumberPayees, check.Equals, 1000)
+}
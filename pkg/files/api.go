@@ -0,0 +1,217 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package files
+
+import (
+	"database/sql"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/base"
+	"github.com/moov-io/irs/pkg/api"
+	"github.com/moov-io/irs/pkg/file"
+	"github.com/moov-io/irs/pkg/storage"
+	"github.com/moov-io/irs/pkg/utils"
+	tmw "github.com/moov-io/tumbler/pkg/middleware"
+)
+
+const (
+	mimeTypeJson  = "application/json"
+	mimeTypeAscii = "text/plain"
+)
+
+// maximum size of uploaded files
+var maxFileSize int64 = 100 * 1024 * 1024
+
+// A Controller binds http requests to the file repository and writes the results to the http response.
+// Files are owned by the tenant of the claims of the request that created them.
+type Controller struct {
+	repository storage.Repository
+}
+
+// NewFilesController creates a default api controller
+func NewFilesController(r storage.Repository) api.Router {
+	return &Controller{repository: r}
+}
+
+// Routes returns all of the api route for the FilesController
+func (c *Controller) Routes() api.Routes {
+	return api.Routes{
+		{
+			Name:        "CreateFile",
+			Method:      strings.ToUpper("Post"),
+			Pattern:     "/files",
+			HandlerFunc: c.CreateFile,
+		},
+		{
+			Name:        "ListFiles",
+			Method:      strings.ToUpper("Get"),
+			Pattern:     "/files",
+			HandlerFunc: c.ListFiles,
+		},
+		{
+			Name:        "GetFile",
+			Method:      strings.ToUpper("Get"),
+			Pattern:     "/files/{fileID}",
+			HandlerFunc: c.GetFile,
+		},
+		{
+			Name:        "DeleteFile",
+			Method:      strings.ToUpper("Delete"),
+			Pattern:     "/files/{fileID}",
+			HandlerFunc: c.DeleteFile,
+		},
+		{
+			Name:        "ValidateFile",
+			Method:      strings.ToUpper("Get"),
+			Pattern:     "/files/{fileID}/validate",
+			HandlerFunc: c.ValidateFile,
+		},
+	}
+}
+
+func errorHandling(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		w.WriteHeader(http.StatusNotFound)
+	case errors.Is(err, utils.ErrInvalidFile), errors.Is(err, utils.ErrInvalidAscii):
+		status := http.StatusBadRequest
+		api.EncodeJSONResponse(api.Error{Error: err.Error()}, &status, w)
+	default:
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// CreateFile - Upload a file in FIRE ascii or JSON format
+func (c *Controller) CreateFile(w http.ResponseWriter, r *http.Request) {
+	tmw.WithClaimsFromRequest(w, r, func(claims tmw.TumblerClaims) {
+		// one more byte than the limit is read, so that larger files are rejected instead of truncated
+		buf, err := ioutil.ReadAll(io.LimitReader(r.Body, maxFileSize+1))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if int64(len(buf)) > maxFileSize {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		f, err := file.CreateFile(buf)
+		if err == nil {
+			// files are stored as fire ascii, so uploaded JSON should be parsable as fire ascii
+			_, err = file.CreateFile(f.Ascii())
+		}
+		if err != nil {
+			status := http.StatusBadRequest
+			api.EncodeJSONResponse(api.Error{Error: err.Error()}, &status, w)
+			return
+		}
+
+		fileID := base.ID()
+		if err := c.repository.SaveFile(claims.TenantID.String(), fileID, f); err != nil {
+			errorHandling(w, err)
+			return
+		}
+
+		status := http.StatusCreated
+		api.EncodeJSONResponse(api.FileID{FileID: fileID}, &status, w)
+	})
+}
+
+// ListFiles - List identifiers of stored files
+func (c *Controller) ListFiles(w http.ResponseWriter, r *http.Request) {
+	tmw.WithClaimsFromRequest(w, r, func(claims tmw.TumblerClaims) {
+		ids, err := c.repository.ListFiles(claims.TenantID.String())
+		if err != nil {
+			errorHandling(w, err)
+			return
+		}
+
+		result := make([]api.FileID, 0, len(ids))
+		for _, id := range ids {
+			result = append(result, api.FileID{FileID: id})
+		}
+		api.EncodeJSONResponse(result, nil, w)
+	})
+}
+
+// GetFile - Get a file in JSON or FIRE ascii format, depending on the accept header
+func (c *Controller) GetFile(w http.ResponseWriter, r *http.Request) {
+	tmw.WithClaimsFromRequest(w, r, func(claims tmw.TumblerClaims) {
+		format := negotiateFormat(r.Header.Get("Accept"))
+		if len(format) == 0 {
+			w.WriteHeader(http.StatusNotAcceptable)
+			return
+		}
+
+		f, err := c.repository.GetFile(claims.TenantID.String(), mux.Vars(r)["fileID"])
+		if err != nil {
+			errorHandling(w, err)
+			return
+		}
+
+		if format == file.FormatJson {
+			api.EncodeJSONResponse(f, nil, w)
+			return
+		}
+
+		w.Header().Set("Content-Type", mimeTypeAscii+"; charset=UTF-8")
+		w.WriteHeader(http.StatusOK)
+		w.Write(f.Ascii())
+	})
+}
+
+// DeleteFile - Delete a stored file
+func (c *Controller) DeleteFile(w http.ResponseWriter, r *http.Request) {
+	tmw.WithClaimsFromRequest(w, r, func(claims tmw.TumblerClaims) {
+		if err := c.repository.DeleteFile(claims.TenantID.String(), mux.Vars(r)["fileID"]); err != nil {
+			errorHandling(w, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// ValidateFile - Validate a stored file and return the report of all problems
+func (c *Controller) ValidateFile(w http.ResponseWriter, r *http.Request) {
+	tmw.WithClaimsFromRequest(w, r, func(claims tmw.TumblerClaims) {
+		f, err := c.repository.GetFile(claims.TenantID.String(), mux.Vars(r)["fileID"])
+		if err != nil {
+			errorHandling(w, err)
+			return
+		}
+
+		report := &utils.ValidationReport{Errors: []*utils.FieldError{}}
+		report.Add(nil, f.Validate())
+		api.EncodeJSONResponse(report, nil, w)
+	})
+}
+
+// negotiateFormat returns format of the file for the accept header, or empty string if no format is acceptable
+func negotiateFormat(accept string) string {
+	if len(strings.TrimSpace(accept)) == 0 {
+		return file.FormatJson
+	}
+
+	for _, mediaRange := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(mediaRange))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case mimeTypeJson, "application/*", "*/*":
+			return file.FormatJson
+		case mimeTypeAscii, "text/*":
+			return file.FormatAscii
+		}
+	}
+	return ""
+}
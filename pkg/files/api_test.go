@@ -0,0 +1,184 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package files
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/moov-io/identity/pkg/database"
+	"github.com/moov-io/identity/pkg/logging"
+	"github.com/moov-io/identity/pkg/stime"
+	"github.com/moov-io/irs/pkg/api"
+	"github.com/moov-io/irs/pkg/storage"
+	"github.com/moov-io/irs/pkg/utils"
+	tmwt "github.com/moov-io/tumbler/pkg/middleware/middlewaretest"
+	"github.com/stretchr/testify/require"
+)
+
+type scope struct {
+	routes *mux.Router
+	// routes of requests of another tenant
+	otherRoutes *mux.Router
+	ascii       []byte
+	json        []byte
+}
+
+func setup(t *testing.T) (*require.Assertions, scope) {
+	a := require.New(t)
+
+	db, close, err := database.NewAndMigrate(database.InMemorySqliteConfig, nil, nil)
+	t.Cleanup(close)
+	a.Nil(err)
+
+	newRoutes := func() *mux.Router {
+		routes := mux.NewRouter()
+		api.AppendRouters(logging.NewNopLogger(), routes, NewFilesController(storage.NewFileRepository(db)))
+		routes.Use(tmwt.NewTestMiddleware(stime.NewStaticTimeService(), tmwt.NewRandomClaims()).Handler)
+		return routes
+	}
+
+	s := scope{routes: newRoutes(), otherRoutes: newRoutes()}
+	s.ascii, err = ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "oneTransactionFile.ascii"))
+	a.Nil(err)
+	s.json, err = ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "oneTransactionFile.json"))
+	a.Nil(err)
+	return a, s
+}
+
+func (s scope) do(method, path string, body []byte, header ...string) *httptest.ResponseRecorder {
+	return serve(s.routes, method, path, body, header...)
+}
+
+func serve(routes *mux.Router, method, path string, body []byte, header ...string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, bytes.NewReader(body))
+	for i := 0; i+1 < len(header); i += 2 {
+		req.Header.Set(header[i], header[i+1])
+	}
+	w := httptest.NewRecorder()
+	routes.ServeHTTP(w, req)
+	return w
+}
+
+func (s scope) create(a *require.Assertions, body []byte) string {
+	w := s.do(http.MethodPost, "/files", body)
+	a.Equal(http.StatusCreated, w.Code)
+	created := api.FileID{}
+	a.Nil(json.Unmarshal(w.Body.Bytes(), &created))
+	a.NotEmpty(created.FileID)
+	return created.FileID
+}
+
+func Test_CreateAndGetFile(t *testing.T) {
+	a, s := setup(t)
+
+	for _, body := range [][]byte{s.ascii, s.json} {
+		fileID := s.create(a, body)
+
+		w := s.do(http.MethodGet, "/files/"+fileID, nil, "Accept", "text/plain")
+		a.Equal(http.StatusOK, w.Code)
+		a.Equal(string(s.ascii), w.Body.String())
+
+		w = s.do(http.MethodGet, "/files/"+fileID, nil, "Accept", "application/json")
+		a.Equal(http.StatusOK, w.Code)
+		a.Contains(w.Header().Get("Content-Type"), "application/json")
+		a.Contains(w.Body.String(), `"payment_persons"`)
+
+		w = s.do(http.MethodGet, "/files/"+fileID, nil, "Accept", "application/pdf")
+		a.Equal(http.StatusNotAcceptable, w.Code)
+	}
+
+	w := s.do(http.MethodGet, "/files", nil)
+	a.Equal(http.StatusOK, w.Code)
+	list := []api.FileID{}
+	a.Nil(json.Unmarshal(w.Body.Bytes(), &list))
+	a.Len(list, 2)
+}
+
+func Test_CreateFile_Invalid(t *testing.T) {
+	a, s := setup(t)
+
+	w := s.do(http.MethodPost, "/files", nil)
+	a.Equal(http.StatusBadRequest, w.Code)
+
+	w = s.do(http.MethodPost, "/files", s.ascii[:len(s.ascii)-100])
+	a.Equal(http.StatusBadRequest, w.Code)
+	a.Contains(w.Body.String(), `"error"`)
+
+	// JSON that can't be stored as fire ascii
+	w = s.do(http.MethodPost, "/files", bytes.Replace(s.json, []byte(`"payer_tin": "123456789"`), []byte(`"payer_tin": ""`), 1))
+	a.Equal(http.StatusBadRequest, w.Code)
+	a.Contains(w.Body.String(), "TIN")
+}
+
+func Test_CreateFile_TooLarge(t *testing.T) {
+	a, s := setup(t)
+
+	size := maxFileSize
+	defer func() { maxFileSize = size }()
+	maxFileSize = int64(len(s.ascii))
+
+	s.create(a, s.ascii)
+	w := s.do(http.MethodPost, "/files", append(s.ascii, s.ascii[:750]...))
+	a.Equal(http.StatusRequestEntityTooLarge, w.Code)
+}
+
+func Test_ValidateFile(t *testing.T) {
+	a, s := setup(t)
+
+	fileID := s.create(a, s.ascii)
+	w := s.do(http.MethodGet, "/files/"+fileID+"/validate", nil)
+	a.Equal(http.StatusOK, w.Code)
+	report := utils.ValidationReport{}
+	a.Nil(json.Unmarshal(w.Body.Bytes(), &report))
+	a.Empty(report.Errors)
+
+	invalid := bytes.Replace(s.json, []byte(`"payer_state": "NY"`), []byte(`"payer_state": "ZZ"`), 1)
+	fileID = s.create(a, invalid)
+	w = s.do(http.MethodGet, "/files/"+fileID+"/validate", nil)
+	a.Equal(http.StatusOK, w.Code)
+	a.Nil(json.Unmarshal(w.Body.Bytes(), &report))
+	a.Len(report.Errors, 1)
+	a.Equal("PayerState", report.Errors[0].FieldName)
+	a.Equal(utils.CodeValidValue, report.Errors[0].Code)
+}
+
+func Test_DeleteFile(t *testing.T) {
+	a, s := setup(t)
+
+	fileID := s.create(a, s.ascii)
+	w := s.do(http.MethodDelete, "/files/"+fileID, nil)
+	a.Equal(http.StatusNoContent, w.Code)
+
+	w = s.do(http.MethodGet, "/files/"+fileID, nil)
+	a.Equal(http.StatusNotFound, w.Code)
+	w = s.do(http.MethodDelete, "/files/"+fileID, nil)
+	a.Equal(http.StatusNotFound, w.Code)
+}
+
+func Test_FilesOfOtherTenants(t *testing.T) {
+	a, s := setup(t)
+
+	fileID := s.create(a, s.ascii)
+	w := serve(s.otherRoutes, http.MethodGet, "/files", nil)
+	a.Equal(http.StatusOK, w.Code)
+	a.JSONEq("[]", w.Body.String())
+	for _, method := range []string{http.MethodGet, http.MethodDelete} {
+		w = serve(s.otherRoutes, method, "/files/"+fileID, nil)
+		a.Equal(http.StatusNotFound, w.Code)
+	}
+	w = serve(s.otherRoutes, http.MethodGet, "/files/"+fileID+"/validate", nil)
+	a.Equal(http.StatusNotFound, w.Code)
+
+	w = s.do(http.MethodGet, "/files/"+fileID, nil, "Accept", "text/plain")
+	a.Equal(http.StatusOK, w.Code)
+	a.Equal(string(s.ascii), w.Body.String())
+}
@@ -0,0 +1,166 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	// padding between border of a text field and its text
+	textPadding = 2.0
+	// default width of glyphs of fonts without widths, that of Helvetica
+	defaultGlyphWidth = 556.0
+	// ratio of line height to font size
+	lineSpacing = 1.15
+	// ratio of height of capital letters to font size
+	capHeight = 0.7
+)
+
+// number returns value of a numeric object
+func number(obj interface{}) float64 {
+	switch v := obj.(type) {
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	}
+	return 0
+}
+
+// defaultAppearance returns the default appearance string of the field along with its font name and size
+func (f *form) defaultAppearance(field *formField) (string, pdfName, float64) {
+	da, ok := f.doc.resolve(field.dict["DA"]).(pdfString)
+	if !ok {
+		da, _ = f.doc.resolve(f.acroForm["DA"]).(pdfString)
+	}
+
+	var font pdfName
+	var size float64
+	p := &parser{buf: da}
+	var operands []interface{}
+	for p.skipSpaces(); p.pos < len(p.buf); p.skipSpaces() {
+		obj, err := p.object()
+		if err != nil {
+			break
+		}
+		if op, ok := obj.(keyword); ok {
+			if op == "Tf" && len(operands) >= 2 {
+				font, _ = operands[len(operands)-2].(pdfName)
+				size = number(operands[len(operands)-1])
+			}
+			operands = nil
+			continue
+		}
+		operands = append(operands, obj)
+	}
+	return string(da), font, size
+}
+
+// textWidth returns width of the text drawn by the font of the size
+func (f *form) textWidth(font pdfDict, text []byte, size float64) float64 {
+	widths, _ := f.doc.resolve(font["Widths"]).(pdfArray)
+	first := int(number(f.doc.resolve(font["FirstChar"])))
+
+	width := 0.0
+	for _, c := range text {
+		glyph := defaultGlyphWidth
+		if i := int(c) - first; i >= 0 && i < len(widths) {
+			glyph = number(f.doc.resolve(widths[i]))
+		}
+		width += glyph
+	}
+	return width * size / 1000
+}
+
+// winAnsi encodes text by the WinAnsiEncoding of standard fonts, replacing unsupported characters
+func winAnsi(text string) []byte {
+	buf := make([]byte, 0, len(text))
+	for _, r := range text {
+		if r < 0x20 || r > 0xff {
+			r = '?'
+		}
+		buf = append(buf, byte(r))
+	}
+	return buf
+}
+
+// appearance creates the normal appearance stream of the widget of the text field showing the value
+func (f *form) appearance(field *formField, widget pdfDict, value string) *pdfStream {
+	rect, _ := f.doc.resolve(widget["Rect"]).(pdfArray)
+	if len(rect) != 4 {
+		return nil
+	}
+	width := number(f.doc.resolve(rect[2])) - number(f.doc.resolve(rect[0]))
+	height := number(f.doc.resolve(rect[3])) - number(f.doc.resolve(rect[1]))
+	if width < 0 {
+		width = -width
+	}
+	if height < 0 {
+		height = -height
+	}
+
+	da, fontName, size := f.defaultAppearance(field)
+	fonts := f.doc.dict(f.doc.dict(f.acroForm["DR"])["Font"])
+	font := f.doc.dict(fonts[fontName])
+	if font == nil {
+		return nil
+	}
+
+	lines := strings.Split(value, "\n")
+	if size <= 0 {
+		// auto sized text fits height of the field
+		size = (height - 2*textPadding) / (float64(len(lines)) * lineSpacing)
+		if size > 12 {
+			size = 12
+		}
+	}
+
+	quadding, ok := f.doc.resolve(field.dict["Q"]).(int64)
+	if !ok {
+		quadding, _ = f.doc.resolve(widget["Q"]).(int64)
+	}
+
+	// a single line is centered vertically, multiple lines start at the top of the field
+	y := (height - size*capHeight) / 2
+	if len(lines) > 1 {
+		y = height - textPadding - size
+	}
+
+	content := &bytes.Buffer{}
+	fmt.Fprintf(content, "/Tx BMC\nq\n%.2f %.2f %.2f %.2f re W n\nBT\n%s\n", 1.0, 1.0, width-2, height-2, da)
+	content.WriteByte('/')
+	content.WriteString(string(fontName))
+	fmt.Fprintf(content, " %.2f Tf\n", size)
+	prevX, prevY := 0.0, 0.0
+	for _, line := range lines {
+		text := winAnsi(line)
+		x := textPadding
+		switch quadding {
+		case 1:
+			x = (width - f.textWidth(font, text, size)) / 2
+		case 2:
+			x = width - textPadding - f.textWidth(font, text, size)
+		}
+		fmt.Fprintf(content, "%.2f %.2f Td ", x-prevX, y-prevY)
+		writeString(content, pdfString(text))
+		content.WriteString(" Tj\n")
+		prevX, prevY = x, y
+		y -= size * lineSpacing
+	}
+	content.WriteString("ET\nQ\nEMC")
+
+	return &pdfStream{
+		Dict: pdfDict{
+			"Type":      pdfName("XObject"),
+			"Subtype":   pdfName("Form"),
+			"BBox":      pdfArray{0.0, 0.0, width, height},
+			"Resources": pdfDict{"Font": pdfDict{fontName: fonts[fontName]}},
+		},
+		Data: content.Bytes(),
+	}
+}
@@ -0,0 +1,552 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+)
+
+// xrefEntry locates an object, either at an offset of the file or inside an object stream
+type xrefEntry struct {
+	offset   int
+	inStream bool
+	stream   int
+	index    int
+}
+
+// document is a pdf file that is changed by incremental updates,
+// keeping the original content of the file untouched
+type document struct {
+	buf     []byte
+	xref    map[int]xrefEntry
+	trailer pdfDict
+	// offset of the last cross-reference section
+	lastXref int
+
+	objects map[int]interface{}
+	streams map[int][]interface{}
+	updated map[int]interface{}
+	size    int
+}
+
+// openDocument reads cross-reference sections and trailer of a pdf file
+func openDocument(buf []byte) (*document, error) {
+	if !bytes.HasPrefix(buf, []byte("%PDF-")) {
+		return nil, errors.New("not a pdf file")
+	}
+
+	d := &document{
+		buf:     buf,
+		xref:    make(map[int]xrefEntry),
+		objects: make(map[int]interface{}),
+		streams: make(map[int][]interface{}),
+		updated: make(map[int]interface{}),
+	}
+
+	start := bytes.LastIndex(buf, []byte("startxref"))
+	if start < 0 {
+		return nil, fmt.Errorf("%w: missing startxref", errSyntax)
+	}
+	p := &parser{buf: buf, pos: start + len("startxref")}
+	tok, err := p.token()
+	if err != nil {
+		return nil, err
+	}
+	if d.lastXref, err = strconv.Atoi(string(tok)); err != nil {
+		return nil, fmt.Errorf("%w: invalid startxref", errSyntax)
+	}
+
+	visited := make(map[int]bool)
+	for offset := d.lastXref; offset > 0 && !visited[offset]; {
+		visited[offset] = true
+		trailer, err := d.readXref(offset)
+		if err != nil {
+			return nil, err
+		}
+		if d.trailer == nil {
+			d.trailer = trailer
+		}
+		if stm, ok := trailer["XRefStm"].(int64); ok {
+			if _, err := d.readXref(int(stm)); err != nil {
+				return nil, err
+			}
+		}
+		prev, _ := trailer["Prev"].(int64)
+		offset = int(prev)
+	}
+
+	if size, ok := d.trailer["Size"].(int64); ok {
+		d.size = int(size)
+	}
+	if _, ok := d.trailer["Root"].(pdfRef); !ok {
+		return nil, fmt.Errorf("%w: missing document catalog", errSyntax)
+	}
+	if _, ok := d.trailer["Encrypt"]; ok {
+		return nil, errors.New("encrypted pdf files are not supported")
+	}
+	return d, nil
+}
+
+// readXref reads a cross-reference table or stream at the offset, keeping entries of newer sections
+func (d *document) readXref(offset int) (pdfDict, error) {
+	if offset >= len(d.buf) {
+		return nil, fmt.Errorf("%w: invalid cross-reference offset %d", errSyntax, offset)
+	}
+	p := &parser{buf: d.buf, pos: offset}
+	tok, err := p.token()
+	if err != nil {
+		return nil, err
+	}
+	if string(tok) == "xref" {
+		return d.readXrefTable(p)
+	}
+
+	p.pos = offset
+	_, obj, err := d.readIndirect(p)
+	if err != nil {
+		return nil, err
+	}
+	stream, ok := obj.(*pdfStream)
+	if !ok || stream.Dict["Type"] != pdfName("XRef") {
+		return nil, fmt.Errorf("%w: invalid cross-reference stream", errSyntax)
+	}
+	return stream.Dict, d.readXrefStream(stream)
+}
+
+func (d *document) readXrefTable(p *parser) (pdfDict, error) {
+	for {
+		obj, err := p.object()
+		if err != nil {
+			return nil, err
+		}
+		if k, ok := obj.(keyword); ok && k == "trailer" {
+			break
+		}
+		first, ok := obj.(int64)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid cross-reference table", errSyntax)
+		}
+		count, err := p.object()
+		if err != nil {
+			return nil, err
+		}
+		n, ok := count.(int64)
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid cross-reference table", errSyntax)
+		}
+		for i := 0; i < int(n); i++ {
+			var fields [3][]byte
+			for j := range fields {
+				if fields[j], err = p.token(); err != nil {
+					return nil, err
+				}
+			}
+			number := int(first) + i
+			if _, ok := d.xref[number]; ok || string(fields[2]) != "n" {
+				continue
+			}
+			offset, _ := strconv.Atoi(string(fields[0]))
+			d.xref[number] = xrefEntry{offset: offset}
+		}
+	}
+
+	obj, err := p.object()
+	if err != nil {
+		return nil, err
+	}
+	trailer, ok := obj.(pdfDict)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid trailer", errSyntax)
+	}
+	return trailer, nil
+}
+
+func (d *document) readXrefStream(stream *pdfStream) error {
+	data, err := d.decode(stream)
+	if err != nil {
+		return err
+	}
+
+	w, ok := stream.Dict["W"].(pdfArray)
+	if !ok || len(w) != 3 {
+		return fmt.Errorf("%w: invalid cross-reference stream widths", errSyntax)
+	}
+	widths := make([]int, 3)
+	rowSize := 0
+	for i := range w {
+		n, _ := w[i].(int64)
+		widths[i] = int(n)
+		rowSize += int(n)
+	}
+
+	index, ok := stream.Dict["Index"].(pdfArray)
+	if !ok {
+		size, _ := stream.Dict["Size"].(int64)
+		index = pdfArray{int64(0), size}
+	}
+
+	pos := 0
+	for i := 0; i+1 < len(index); i += 2 {
+		first, _ := index[i].(int64)
+		count, _ := index[i+1].(int64)
+		for j := 0; j < int(count); j++ {
+			if pos+rowSize > len(data) {
+				return fmt.Errorf("%w: truncated cross-reference stream", errSyntax)
+			}
+			fields := make([]int, 3)
+			for k := range fields {
+				for l := 0; l < widths[k]; l++ {
+					fields[k] = fields[k]<<8 | int(data[pos])
+					pos++
+				}
+			}
+			if widths[0] == 0 {
+				// type defaults to uncompressed objects
+				fields[0] = 1
+			}
+
+			number := int(first) + j
+			if _, ok := d.xref[number]; ok {
+				continue
+			}
+			switch fields[0] {
+			case 1:
+				d.xref[number] = xrefEntry{offset: fields[1]}
+			case 2:
+				d.xref[number] = xrefEntry{inStream: true, stream: fields[1], index: fields[2]}
+			}
+		}
+	}
+	return nil
+}
+
+// readIndirect reads an indirect object "n g obj ... endobj" at the position of the parser
+func (d *document) readIndirect(p *parser) (int, interface{}, error) {
+	var header [3][]byte
+	for i := range header {
+		tok, err := p.token()
+		if err != nil {
+			return 0, nil, err
+		}
+		header[i] = tok
+	}
+	number, err := strconv.Atoi(string(header[0]))
+	if err != nil || string(header[2]) != "obj" {
+		return 0, nil, fmt.Errorf("%w: invalid indirect object at offset %d", errSyntax, p.pos)
+	}
+
+	p.length = d.length
+	obj, err := p.object()
+	return number, obj, err
+}
+
+// length resolves length of a stream, which may be an indirect object
+func (d *document) length(obj interface{}) (int, bool) {
+	ref, ok := obj.(pdfRef)
+	if !ok {
+		return 0, false
+	}
+	resolved, err := d.object(ref.Number)
+	if err != nil {
+		return 0, false
+	}
+	n, ok := resolved.(int64)
+	return int(n), ok
+}
+
+// object returns the object of the number, including updated objects
+func (d *document) object(number int) (interface{}, error) {
+	if obj, ok := d.updated[number]; ok {
+		return obj, nil
+	}
+	if obj, ok := d.objects[number]; ok {
+		return obj, nil
+	}
+
+	entry, ok := d.xref[number]
+	if !ok {
+		return nil, nil
+	}
+
+	var obj interface{}
+	if entry.inStream {
+		objects, err := d.objectStream(entry.stream)
+		if err != nil {
+			return nil, err
+		}
+		if entry.index >= len(objects) {
+			return nil, fmt.Errorf("%w: object %d not found in object stream %d", errSyntax, number, entry.stream)
+		}
+		obj = objects[entry.index]
+	} else {
+		p := &parser{buf: d.buf, pos: entry.offset}
+		var err error
+		if _, obj, err = d.readIndirect(p); err != nil {
+			return nil, err
+		}
+	}
+
+	d.objects[number] = obj
+	return obj, nil
+}
+
+// objectStream returns all objects of the object stream
+func (d *document) objectStream(number int) ([]interface{}, error) {
+	if objects, ok := d.streams[number]; ok {
+		return objects, nil
+	}
+
+	obj, err := d.object(number)
+	if err != nil {
+		return nil, err
+	}
+	stream, ok := obj.(*pdfStream)
+	if !ok || stream.Dict["Type"] != pdfName("ObjStm") {
+		return nil, fmt.Errorf("%w: invalid object stream %d", errSyntax, number)
+	}
+	data, err := d.decode(stream)
+	if err != nil {
+		return nil, err
+	}
+
+	n, _ := stream.Dict["N"].(int64)
+	first, _ := stream.Dict["First"].(int64)
+	p := &parser{buf: data}
+	offsets := make([]int, n)
+	for i := range offsets {
+		if _, err := p.object(); err != nil {
+			return nil, err
+		}
+		offset, err := p.object()
+		if err != nil {
+			return nil, err
+		}
+		o, _ := offset.(int64)
+		offsets[i] = int(first + o)
+	}
+
+	objects := make([]interface{}, n)
+	for i, offset := range offsets {
+		if offset > len(data) {
+			return nil, fmt.Errorf("%w: invalid object stream %d", errSyntax, number)
+		}
+		p.pos = offset
+		if objects[i], err = p.object(); err != nil {
+			return nil, err
+		}
+	}
+
+	d.streams[number] = objects
+	return objects, nil
+}
+
+// resolve returns the referenced object if the object is a reference
+func (d *document) resolve(obj interface{}) interface{} {
+	ref, ok := obj.(pdfRef)
+	if !ok {
+		return obj
+	}
+	resolved, err := d.object(ref.Number)
+	if err != nil {
+		return nil
+	}
+	return resolved
+}
+
+// dict returns the dictionary of the object, resolving references
+func (d *document) dict(obj interface{}) pdfDict {
+	switch v := d.resolve(obj).(type) {
+	case pdfDict:
+		return v
+	case *pdfStream:
+		return v.Dict
+	}
+	return nil
+}
+
+// decode returns decoded data of the stream
+func (d *document) decode(stream *pdfStream) ([]byte, error) {
+	filters := pdfArray{}
+	switch f := d.resolve(stream.Dict["Filter"]).(type) {
+	case pdfName:
+		filters = pdfArray{f}
+	case pdfArray:
+		filters = f
+	}
+	params := pdfArray{}
+	switch p := d.resolve(stream.Dict["DecodeParms"]).(type) {
+	case pdfDict:
+		params = pdfArray{p}
+	case pdfArray:
+		params = p
+	}
+
+	data := stream.Data
+	for i, filter := range filters {
+		if filter != pdfName("FlateDecode") {
+			return nil, fmt.Errorf("unsupported pdf filter %v", filter)
+		}
+		r, err := zlib.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if data, err = ioutil.ReadAll(r); err != nil {
+			return nil, err
+		}
+		if i < len(params) {
+			if data, err = unpredict(data, d.dict(params[i])); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return data, nil
+}
+
+// unpredict reverses png predictors of flate encoded data
+func unpredict(data []byte, params pdfDict) ([]byte, error) {
+	predictor, _ := params["Predictor"].(int64)
+	if predictor < 10 {
+		return data, nil
+	}
+	columns, ok := params["Columns"].(int64)
+	if !ok {
+		columns = 1
+	}
+	colors, ok := params["Colors"].(int64)
+	if !ok {
+		colors = 1
+	}
+	bits, ok := params["BitsPerComponent"].(int64)
+	if !ok {
+		bits = 8
+	}
+	bpp := int((colors*bits + 7) / 8)
+	rowSize := int((columns*colors*bits + 7) / 8)
+
+	var out []byte
+	prev := make([]byte, rowSize)
+	for pos := 0; pos < len(data); pos += rowSize + 1 {
+		if pos+1+rowSize > len(data) {
+			return nil, fmt.Errorf("%w: truncated predictor row", errSyntax)
+		}
+		filter := data[pos]
+		row := append([]byte(nil), data[pos+1:pos+1+rowSize]...)
+		for i := range row {
+			var left, upLeft byte
+			if i >= bpp {
+				left = row[i-bpp]
+				upLeft = prev[i-bpp]
+			}
+			up := prev[i]
+			switch filter {
+			case 1:
+				row[i] += left
+			case 2:
+				row[i] += up
+			case 3:
+				row[i] += byte((int(left) + int(up)) / 2)
+			case 4:
+				row[i] += paeth(left, up, upLeft)
+			}
+		}
+		out = append(out, row...)
+		prev = row
+	}
+	return out, nil
+}
+
+func paeth(a, b, c byte) byte {
+	p := int(a) + int(b) - int(c)
+	pa, pb, pc := abs(p-int(a)), abs(p-int(b)), abs(p-int(c))
+	switch {
+	case pa <= pb && pa <= pc:
+		return a
+	case pb <= pc:
+		return b
+	}
+	return c
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// update replaces the object of the number by the incremental update
+func (d *document) update(number int, obj interface{}) {
+	d.updated[number] = obj
+}
+
+// add adds a new object to the incremental update, returning its reference
+func (d *document) add(obj interface{}) pdfRef {
+	number := d.size
+	d.size++
+	d.updated[number] = obj
+	return pdfRef{Number: number}
+}
+
+// bytes returns the original file followed by the incremental update of changed objects
+func (d *document) bytes() []byte {
+	out := bytes.NewBuffer(append([]byte(nil), d.buf...))
+	if len(d.updated) == 0 {
+		return out.Bytes()
+	}
+	if !bytes.HasSuffix(d.buf, []byte("\n")) {
+		out.WriteByte('\n')
+	}
+
+	numbers := make([]int, 0, len(d.updated)+1)
+	for number := range d.updated {
+		numbers = append(numbers, number)
+	}
+	sort.Ints(numbers)
+
+	offsets := make(map[int]int)
+	for _, number := range numbers {
+		offsets[number] = out.Len()
+		fmt.Fprintf(out, "%d 0 obj\n", number)
+		writeObject(out, d.updated[number])
+		out.WriteString("\nendobj\n")
+	}
+
+	// the update is indexed by a cross-reference stream, like the original file
+	xrefNumber := d.size
+	offsets[xrefNumber] = out.Len()
+	numbers = append(numbers, xrefNumber)
+
+	var index pdfArray
+	var data []byte
+	for _, number := range numbers {
+		index = append(index, int64(number), int64(1))
+		offset := offsets[number]
+		data = append(data, 1, byte(offset>>24), byte(offset>>16), byte(offset>>8), byte(offset), 0, 0)
+	}
+
+	dict := pdfDict{
+		"Type":  pdfName("XRef"),
+		"Size":  int64(xrefNumber + 1),
+		"Prev":  int64(d.lastXref),
+		"W":     pdfArray{int64(1), int64(4), int64(2)},
+		"Index": index,
+	}
+	for _, key := range []pdfName{"Root", "Info", "ID"} {
+		if value, ok := d.trailer[key]; ok {
+			dict[key] = value
+		}
+	}
+
+	fmt.Fprintf(out, "%d 0 obj\n", xrefNumber)
+	writeObject(out, &pdfStream{Dict: dict, Data: data})
+	fmt.Fprintf(out, "\nendobj\nstartxref\n%d\n%%%%EOF\n", offsets[xrefNumber])
+	return out.Bytes()
+}
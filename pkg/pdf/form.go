@@ -0,0 +1,289 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pdf
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var errNoForm = errors.New("pdf file has no interactive form")
+
+// formField is a terminal field of the interactive form
+type formField struct {
+	name    string
+	ref     pdfRef
+	dict    pdfDict
+	kind    pdfName
+	widgets []pdfRef
+}
+
+// form is the interactive form (AcroForm) of a pdf document
+type form struct {
+	doc      *document
+	acroForm pdfDict
+	fields   map[string]*formField
+	// names of fields in order of the document
+	names []string
+}
+
+// openForm reads fields of the interactive form of the pdf file
+func openForm(buf []byte) (*form, error) {
+	doc, err := openDocument(buf)
+	if err != nil {
+		return nil, err
+	}
+	catalog := doc.dict(doc.trailer["Root"])
+	acroForm := doc.dict(catalog["AcroForm"])
+	if acroForm == nil {
+		return nil, errNoForm
+	}
+
+	f := &form{doc: doc, acroForm: acroForm, fields: make(map[string]*formField)}
+	fields, _ := doc.resolve(acroForm["Fields"]).(pdfArray)
+	for _, kid := range fields {
+		f.readField(kid, "", "", 0)
+	}
+	return f, nil
+}
+
+// readField reads the field and its descendants, inheriting name and type of the parent field
+func (f *form) readField(obj interface{}, parentName string, parentKind pdfName, depth int) {
+	ref, ok := obj.(pdfRef)
+	dict := f.doc.dict(obj)
+	if !ok || dict == nil || depth > 32 {
+		return
+	}
+
+	name := parentName
+	if t, ok := f.doc.resolve(dict["T"]).(pdfString); ok {
+		if len(name) > 0 {
+			name += "."
+		}
+		name += decodeText(t)
+	}
+	kind := parentKind
+	if ft, ok := dict["FT"].(pdfName); ok {
+		kind = ft
+	}
+
+	kids, _ := f.doc.resolve(dict["Kids"]).(pdfArray)
+	field := &formField{name: name, ref: ref, dict: dict, kind: kind}
+	for _, kid := range kids {
+		kidDict := f.doc.dict(kid)
+		if kidDict == nil {
+			continue
+		}
+		if _, ok := kidDict["T"]; ok {
+			f.readField(kid, name, kind, depth+1)
+			continue
+		}
+		// kids without names are widget annotations of the field
+		if kidRef, ok := kid.(pdfRef); ok {
+			field.widgets = append(field.widgets, kidRef)
+		}
+	}
+	if len(kids) == 0 {
+		// the field and its widget annotation are merged into a single dictionary
+		field.widgets = append(field.widgets, ref)
+	}
+	if len(field.widgets) == 0 {
+		return
+	}
+
+	if _, ok := f.fields[name]; !ok {
+		f.names = append(f.names, name)
+	}
+	f.fields[name] = field
+}
+
+// onState returns name of the "on" appearance state of the check box
+func (f *form) onState(field *formField) pdfName {
+	for _, ref := range field.widgets {
+		widget := f.doc.dict(ref)
+		ap := f.doc.dict(widget["AP"])
+		normal := f.doc.dict(ap["N"])
+		for state := range normal {
+			if state != "Off" {
+				return state
+			}
+		}
+	}
+	return "Yes"
+}
+
+// setText sets value of the text field
+func (f *form) setText(name, value string) error {
+	field, ok := f.fields[name]
+	if !ok {
+		return fmt.Errorf("unknown form field %s", name)
+	}
+	if field.kind != "Tx" {
+		return fmt.Errorf("form field %s isn't a text field", name)
+	}
+
+	if maxLen, ok := f.doc.resolve(field.dict["MaxLen"]).(int64); ok && int64(len(value)) > maxLen {
+		value = value[:maxLen]
+	}
+	field.dict["V"] = textString(value)
+	f.doc.update(field.ref.Number, field.dict)
+	for _, ref := range field.widgets {
+		widget := f.doc.dict(ref)
+		if widget == nil {
+			continue
+		}
+		if ap := f.appearance(field, widget, value); ap != nil {
+			widget["AP"] = pdfDict{"N": f.doc.add(ap)}
+		} else {
+			// without an appearance of the value, viewers generate it
+			delete(widget, "AP")
+		}
+		f.doc.update(ref.Number, widget)
+	}
+	return nil
+}
+
+// setCheck checks or unchecks the check box
+func (f *form) setCheck(name string, checked bool) error {
+	field, ok := f.fields[name]
+	if !ok {
+		return fmt.Errorf("unknown form field %s", name)
+	}
+	if field.kind != "Btn" {
+		return fmt.Errorf("form field %s isn't a check box", name)
+	}
+
+	state := pdfName("Off")
+	if checked {
+		state = f.onState(field)
+	}
+	field.dict["V"] = state
+	f.doc.update(field.ref.Number, field.dict)
+	for _, ref := range field.widgets {
+		widget := f.doc.dict(ref)
+		if widget == nil {
+			continue
+		}
+		widget["AS"] = state
+		f.doc.update(ref.Number, widget)
+	}
+	return nil
+}
+
+// bytes returns the pdf file with the filled form
+func (f *form) bytes() []byte {
+	// values of fields are kept only in the acro form, so that viewers don't render the original XFA form
+	delete(f.acroForm, "XFA")
+	f.acroForm["NeedAppearances"] = true
+	catalog := f.doc.trailer["Root"].(pdfRef)
+	if ref, ok := f.doc.dict(catalog)["AcroForm"].(pdfRef); ok {
+		f.doc.update(ref.Number, f.acroForm)
+	} else {
+		f.doc.update(catalog.Number, f.doc.dict(catalog))
+	}
+	return f.doc.bytes()
+}
+
+// fieldsWithPrefix returns names of fields starting with the prefix
+func (f *form) fieldsWithPrefix(prefix string) []string {
+	var names []string
+	for _, name := range f.names {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// pages returns references of all pages of the page tree node
+func (f *form) pages(node interface{}, depth int) []pdfRef {
+	dict := f.doc.dict(node)
+	ref, ok := node.(pdfRef)
+	if dict == nil || !ok || depth > 32 {
+		return nil
+	}
+	if dict["Type"] == pdfName("Page") {
+		return []pdfRef{ref}
+	}
+
+	var pages []pdfRef
+	kids, _ := f.doc.resolve(dict["Kids"]).(pdfArray)
+	for _, kid := range kids {
+		pages = append(pages, f.pages(kid, depth+1)...)
+	}
+	return pages
+}
+
+// keepPages removes all pages except pages with fields of the prefixes,
+// and pages without fields following them, such as instructions printed on the back
+func (f *form) keepPages(prefixes []string) error {
+	owners := make(map[pdfRef]string)
+	for _, name := range f.names {
+		for _, ref := range f.fields[name].widgets {
+			owners[ref] = name
+		}
+	}
+
+	root, ok := f.doc.dict(f.doc.trailer["Root"])["Pages"].(pdfRef)
+	if !ok {
+		return fmt.Errorf("%w: missing page tree", errSyntax)
+	}
+
+	var kept pdfArray
+	keepBack := false
+	for _, page := range f.pages(root, 0) {
+		dict := f.doc.dict(page)
+		hasFields, keep := false, false
+		annots, _ := f.doc.resolve(dict["Annots"]).(pdfArray)
+		for _, annot := range annots {
+			ref, _ := annot.(pdfRef)
+			name, ok := owners[ref]
+			if !ok {
+				continue
+			}
+			hasFields = true
+			for _, prefix := range prefixes {
+				keep = keep || strings.HasPrefix(name, prefix)
+			}
+		}
+		if !hasFields {
+			keep = keepBack
+		}
+		keepBack = keep && hasFields
+		if !keep {
+			continue
+		}
+
+		kept = append(kept, page)
+		if parent, _ := dict["Parent"].(pdfRef); parent != root {
+			f.inheritAttributes(dict)
+			dict["Parent"] = root
+			f.doc.update(page.Number, dict)
+		}
+	}
+	if len(kept) == 0 {
+		return errors.New("no pages to keep")
+	}
+
+	pages := f.doc.dict(root)
+	pages["Kids"] = kept
+	pages["Count"] = int64(len(kept))
+	f.doc.update(root.Number, pages)
+	return nil
+}
+
+// inheritAttributes copies inheritable attributes of ancestors of the page to the page
+func (f *form) inheritAttributes(page pdfDict) {
+	node := f.doc.dict(page["Parent"])
+	for depth := 0; node != nil && depth < 32; depth++ {
+		for _, key := range []pdfName{"Resources", "MediaBox", "CropBox", "Rotate"} {
+			if _, ok := page[key]; !ok && node[key] != nil {
+				page[key] = node[key]
+			}
+		}
+		node = f.doc.dict(node["Parent"])
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"unicode/utf16"
+)
+
+// pdf objects are represented by go values:
+//   null        nil
+//   boolean     bool
+//   integer     int64
+//   real        float64
+//   string      pdfString
+//   name        pdfName
+//   array       pdfArray
+//   dictionary  pdfDict
+//   stream      *pdfStream
+//   reference   pdfRef
+
+type pdfName string
+
+type pdfString []byte
+
+type pdfArray []interface{}
+
+type pdfDict map[pdfName]interface{}
+
+type pdfStream struct {
+	Dict pdfDict
+	Data []byte
+}
+
+type pdfRef struct {
+	Number     int
+	Generation int
+}
+
+// keyword is a bare token of the content, such as obj, endobj, stream or R
+type keyword string
+
+// textString encodes text as pdf text string, using utf-16 if text isn't ascii
+func textString(text string) pdfString {
+	ascii := true
+	for _, r := range text {
+		if r > 0x7e {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return pdfString(text)
+	}
+
+	buf := []byte{0xfe, 0xff}
+	for _, c := range utf16.Encode([]rune(text)) {
+		buf = append(buf, byte(c>>8), byte(c))
+	}
+	return pdfString(buf)
+}
+
+// decodeText decodes pdf text string
+func decodeText(s pdfString) string {
+	if len(s) >= 2 && s[0] == 0xfe && s[1] == 0xff {
+		units := make([]uint16, 0, len(s)/2)
+		for i := 2; i+1 < len(s); i += 2 {
+			units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+		}
+		return string(utf16.Decode(units))
+	}
+	return string(s)
+}
+
+// writeObject writes serialization of the object
+func writeObject(buf *bytes.Buffer, obj interface{}) {
+	switch v := obj.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		buf.WriteString(strconv.FormatBool(v))
+	case int64:
+		buf.WriteString(strconv.FormatInt(v, 10))
+	case int:
+		buf.WriteString(strconv.Itoa(v))
+	case float64:
+		buf.WriteString(strconv.FormatFloat(v, 'f', -1, 64))
+	case pdfName:
+		writeName(buf, v)
+	case pdfString:
+		writeString(buf, v)
+	case pdfRef:
+		fmt.Fprintf(buf, "%d %d R", v.Number, v.Generation)
+	case pdfArray:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteByte(' ')
+			}
+			writeObject(buf, item)
+		}
+		buf.WriteByte(']')
+	case pdfDict:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, string(key))
+		}
+		sort.Strings(keys)
+		buf.WriteString("<<")
+		for _, key := range keys {
+			writeName(buf, pdfName(key))
+			buf.WriteByte(' ')
+			writeObject(buf, v[pdfName(key)])
+		}
+		buf.WriteString(">>")
+	case *pdfStream:
+		v.Dict["Length"] = int64(len(v.Data))
+		writeObject(buf, v.Dict)
+		buf.WriteString("\nstream\n")
+		buf.Write(v.Data)
+		buf.WriteString("\nendstream")
+	default:
+		panic(fmt.Sprintf("unsupported pdf object %T", obj))
+	}
+}
+
+func writeName(buf *bytes.Buffer, name pdfName) {
+	buf.WriteByte('/')
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c < '!' || c > '~' || isDelimiter(c) || c == '#' {
+			fmt.Fprintf(buf, "#%02X", c)
+			continue
+		}
+		buf.WriteByte(c)
+	}
+}
+
+func writeString(buf *bytes.Buffer, s pdfString) {
+	buf.WriteByte('(')
+	for _, c := range s {
+		switch c {
+		case '(', ')', '\\':
+			buf.WriteByte('\\')
+			buf.WriteByte(c)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\n':
+			buf.WriteString(`\n`)
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	buf.WriteByte(')')
+}
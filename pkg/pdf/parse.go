@@ -0,0 +1,327 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pdf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var errSyntax = errors.New("invalid pdf syntax")
+
+// parser reads pdf objects from the content starting at the position
+type parser struct {
+	buf []byte
+	pos int
+
+	// resolves indirect lengths of streams, may be nil
+	length func(obj interface{}) (int, bool)
+}
+
+func isWhitespace(c byte) bool {
+	return c == 0 || c == '\t' || c == '\n' || c == '\f' || c == '\r' || c == ' '
+}
+
+func isDelimiter(c byte) bool {
+	return bytes.IndexByte([]byte("()<>[]{}/%"), c) >= 0
+}
+
+func (p *parser) skipSpaces() {
+	for p.pos < len(p.buf) {
+		c := p.buf[p.pos]
+		switch {
+		case isWhitespace(c):
+			p.pos++
+		case c == '%':
+			for p.pos < len(p.buf) && p.buf[p.pos] != '\n' && p.buf[p.pos] != '\r' {
+				p.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// token returns the next token, that is a delimiter or a regular token
+func (p *parser) token() ([]byte, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.buf) {
+		return nil, fmt.Errorf("%w: unexpected end of content", errSyntax)
+	}
+
+	start := p.pos
+	c := p.buf[p.pos]
+	switch {
+	case c == '<' || c == '>':
+		if p.pos+1 < len(p.buf) && p.buf[p.pos+1] == c {
+			p.pos += 2
+		} else {
+			p.pos++
+		}
+	case c == '/':
+		p.pos++
+		for p.pos < len(p.buf) && !isWhitespace(p.buf[p.pos]) && !isDelimiter(p.buf[p.pos]) {
+			p.pos++
+		}
+	case isDelimiter(c):
+		p.pos++
+	default:
+		for p.pos < len(p.buf) && !isWhitespace(p.buf[p.pos]) && !isDelimiter(p.buf[p.pos]) {
+			p.pos++
+		}
+	}
+	return p.buf[start:p.pos], nil
+}
+
+// object reads the next object, including references and streams
+func (p *parser) object() (interface{}, error) {
+	tok, err := p.token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tok[0] {
+	case '/':
+		return parseName(tok[1:])
+	case '(':
+		return p.literalString()
+	case '<':
+		if len(tok) == 1 {
+			return p.hexString()
+		}
+		return p.dictOrStream()
+	case '[':
+		return p.array()
+	case ']', '>', ')', '{', '}':
+		return keyword(tok), nil
+	}
+
+	switch string(tok) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+
+	if isNumber(tok) {
+		return p.numberOrRef(tok)
+	}
+	return keyword(tok), nil
+}
+
+func isNumber(tok []byte) bool {
+	for i, c := range tok {
+		if (c < '0' || c > '9') && c != '.' && !(i == 0 && (c == '-' || c == '+')) {
+			return false
+		}
+	}
+	return true
+}
+
+// numberOrRef reads a number, or a reference if the number is followed by a generation and R
+func (p *parser) numberOrRef(tok []byte) (interface{}, error) {
+	if bytes.IndexByte(tok, '.') >= 0 {
+		f, err := strconv.ParseFloat(string(tok), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errSyntax, err)
+		}
+		return f, nil
+	}
+	n, err := strconv.ParseInt(string(tok), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errSyntax, err)
+	}
+
+	saved := p.pos
+	if gen, err := p.token(); err == nil && isNumber(gen) && bytes.IndexByte(gen, '.') < 0 {
+		if r, err := p.token(); err == nil && string(r) == "R" {
+			g, _ := strconv.Atoi(string(gen))
+			return pdfRef{Number: int(n), Generation: g}, nil
+		}
+	}
+	p.pos = saved
+	return n, nil
+}
+
+func parseName(raw []byte) (pdfName, error) {
+	if bytes.IndexByte(raw, '#') < 0 {
+		return pdfName(raw), nil
+	}
+	name := make([]byte, 0, len(raw))
+	for i := 0; i < len(raw); i++ {
+		if raw[i] == '#' && i+2 < len(raw) {
+			c, err := strconv.ParseUint(string(raw[i+1:i+3]), 16, 8)
+			if err != nil {
+				return "", fmt.Errorf("%w: invalid name %s", errSyntax, raw)
+			}
+			name = append(name, byte(c))
+			i += 2
+			continue
+		}
+		name = append(name, raw[i])
+	}
+	return pdfName(name), nil
+}
+
+func (p *parser) literalString() (pdfString, error) {
+	var s []byte
+	depth := 1
+	for p.pos < len(p.buf) {
+		c := p.buf[p.pos]
+		p.pos++
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return s, nil
+			}
+		case '\\':
+			if p.pos >= len(p.buf) {
+				break
+			}
+			c = p.buf[p.pos]
+			p.pos++
+			switch c {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case '\r':
+				if p.pos < len(p.buf) && p.buf[p.pos] == '\n' {
+					p.pos++
+				}
+				continue
+			case '\n':
+				continue
+			default:
+				if c >= '0' && c <= '7' {
+					v := int(c - '0')
+					for i := 0; i < 2 && p.pos < len(p.buf) && p.buf[p.pos] >= '0' && p.buf[p.pos] <= '7'; i++ {
+						v = v*8 + int(p.buf[p.pos]-'0')
+						p.pos++
+					}
+					c = byte(v)
+				}
+			}
+		}
+		s = append(s, c)
+	}
+	return nil, fmt.Errorf("%w: unterminated string", errSyntax)
+}
+
+func (p *parser) hexString() (pdfString, error) {
+	end := bytes.IndexByte(p.buf[p.pos:], '>')
+	if end < 0 {
+		return nil, fmt.Errorf("%w: unterminated hex string", errSyntax)
+	}
+	var digits []byte
+	for _, c := range p.buf[p.pos : p.pos+end] {
+		if !isWhitespace(c) {
+			digits = append(digits, c)
+		}
+	}
+	p.pos += end + 1
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+
+	s := make(pdfString, len(digits)/2)
+	for i := range s {
+		c, err := strconv.ParseUint(string(digits[2*i:2*i+2]), 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid hex string", errSyntax)
+		}
+		s[i] = byte(c)
+	}
+	return s, nil
+}
+
+func (p *parser) array() (pdfArray, error) {
+	arr := pdfArray{}
+	for {
+		obj, err := p.object()
+		if err != nil {
+			return nil, err
+		}
+		if k, ok := obj.(keyword); ok && k == "]" {
+			return arr, nil
+		}
+		arr = append(arr, obj)
+	}
+}
+
+func (p *parser) dictOrStream() (interface{}, error) {
+	dict := pdfDict{}
+	for {
+		obj, err := p.object()
+		if err != nil {
+			return nil, err
+		}
+		if k, ok := obj.(keyword); ok && k == ">>" {
+			break
+		}
+		key, ok := obj.(pdfName)
+		if !ok {
+			return nil, fmt.Errorf("%w: dictionary key %v isn't a name", errSyntax, obj)
+		}
+		if dict[key], err = p.object(); err != nil {
+			return nil, err
+		}
+	}
+
+	saved := p.pos
+	if tok, err := p.token(); err != nil || string(tok) != "stream" {
+		p.pos = saved
+		return dict, nil
+	}
+	return p.stream(dict)
+}
+
+// stream reads data of the stream, following the stream keyword
+func (p *parser) stream(dict pdfDict) (*pdfStream, error) {
+	if p.pos < len(p.buf) && p.buf[p.pos] == '\r' {
+		p.pos++
+	}
+	if p.pos < len(p.buf) && p.buf[p.pos] == '\n' {
+		p.pos++
+	}
+
+	length, ok := -1, false
+	switch l := dict["Length"].(type) {
+	case int64:
+		length, ok = int(l), true
+	default:
+		if p.length != nil {
+			length, ok = p.length(l)
+		}
+	}
+	if !ok || length < 0 || p.pos+length > len(p.buf) {
+		// fall back to searching the end of the stream
+		end := bytes.Index(p.buf[p.pos:], []byte("endstream"))
+		if end < 0 {
+			return nil, fmt.Errorf("%w: unterminated stream", errSyntax)
+		}
+		length = len(bytes.TrimRight(p.buf[p.pos:p.pos+end], "\r\n"))
+	}
+
+	data := p.buf[p.pos : p.pos+length]
+	p.pos += length
+	if tok, err := p.token(); err != nil || string(tok) != "endstream" {
+		return nil, fmt.Errorf("%w: missing endstream", errSyntax)
+	}
+	return &pdfStream{Dict: dict, Data: data}, nil
+}
@@ -0,0 +1,388 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package pdf fills pdf forms of information returns with data of payer “A” and payee “B” records,
+// producing statements furnished to recipients.
+package pdf
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/file"
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/moov-io/irs/pkg/subrecords"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+// ErrUnsupportedForm is given when there is no pdf form of the type of return
+var ErrUnsupportedForm = errors.New("has no supported pdf form")
+
+// ErrNonemployeeCompensation is given when a 1099-MISC payee reports nonemployee compensation (amount code 7),
+// which was box 7 of 1099-MISC before 2020 and is reported by box 1 of 1099-NEC on the supported forms
+var ErrNonemployeeCompensation = errors.New("payment amount 7 is nonemployee compensation, which has no box on the 1099-MISC form since box 7 moved to box 1 of 1099-NEC")
+
+// Copy identifies a copy of a form
+type Copy string
+
+const (
+	// Copy1 is furnished for the state tax department
+	Copy1 Copy = "Copy1"
+	// CopyB is furnished for the recipient
+	CopyB Copy = "CopyB"
+	// Copy2 is furnished to be filed with the recipient’s state income tax return
+	Copy2 Copy = "Copy2"
+)
+
+// RecipientCopies are copies of forms furnished to recipients
+var RecipientCopies = []Copy{Copy1, CopyB, Copy2}
+
+// Templates are file names of pdf forms, keyed by type of return
+var Templates = map[string]string{
+	config.Sub1099MiscType: "f1099msc.pdf",
+	config.Sub1099NecType:  "f1099nec.pdf",
+}
+
+// names of boxes of forms
+const (
+	boxPayer            = "payer"
+	boxPayerTIN         = "payerTIN"
+	boxRecipientTIN     = "recipientTIN"
+	boxRecipientName    = "recipientName"
+	boxStreetAddress    = "streetAddress"
+	boxCityStateZip     = "cityStateZip"
+	boxAccountNumber    = "accountNumber"
+	boxStateTaxWithheld = "stateTaxWithheld"
+	boxStateNumber      = "stateNumber"
+	boxFATCA            = "fatca"
+	boxDirectSales      = "directSales"
+)
+
+// formLayout maps boxes of a form to partial names of their fields, which are the same in all copies of the form
+type formLayout struct {
+	text    map[string]string
+	checks  map[string]string
+	amounts map[string]string
+}
+
+var layouts = map[string]formLayout{
+	config.Sub1099MiscType: {
+		text: map[string]string{
+			boxPayer:            "f2_1[0]",
+			boxPayerTIN:         "f2_2[0]",
+			boxRecipientTIN:     "f2_3[0]",
+			boxRecipientName:    "f2_4[0]",
+			boxStreetAddress:    "f2_5[0]",
+			boxCityStateZip:     "f2_6[0]",
+			boxAccountNumber:    "f2_7[0]",
+			boxStateTaxWithheld: "f2_22[0]",
+			boxStateNumber:      "f2_24[0]",
+		},
+		checks: map[string]string{
+			boxFATCA:       "c2_2[0]",
+			boxDirectSales: "c2_4[0]",
+		},
+		amounts: map[string]string{
+			"1": "f2_8[0]",  // box 1, rents
+			"2": "f2_9[0]",  // box 2, royalties
+			"3": "f2_10[0]", // box 3, other income
+			"4": "f2_11[0]", // box 4, federal income tax withheld
+			"5": "f2_12[0]", // box 5, fishing boat proceeds
+			"6": "f2_13[0]", // box 6, medical and health care payments
+			"8": "f2_15[0]", // box 8, substitute payments in lieu of dividends or interest
+			"A": "f2_16[0]", // box 9, crop insurance proceeds
+			"C": "f2_17[0]", // box 10, gross proceeds paid to an attorney
+			"D": "f2_14[0]", // box 12, section 409A deferrals
+			"B": "f2_20[0]", // box 13, excess golden parachute payments
+			"E": "f2_21[0]", // box 14, nonqualified deferred compensation
+		},
+	},
+	config.Sub1099NecType: {
+		text: map[string]string{
+			boxPayer:            "f2_1[0]",
+			boxPayerTIN:         "f2_2[0]",
+			boxRecipientTIN:     "f2_3[0]",
+			boxRecipientName:    "f2_4[0]",
+			boxStreetAddress:    "f2_5[0]",
+			boxCityStateZip:     "f2_6[0]",
+			boxAccountNumber:    "f2_7[0]",
+			boxStateTaxWithheld: "f2_10[0]",
+			boxStateNumber:      "f2_12[0]",
+		},
+		checks: map[string]string{
+			boxFATCA: "c2_2[0]",
+		},
+		amounts: map[string]string{
+			"1": "f2_8[0]", // box 1, nonemployee compensation
+			"4": "f2_9[0]", // box 4, federal income tax withheld
+		},
+	},
+}
+
+// FillPayee fills copies of the form of the payee “B” record reported by the payer “A” record,
+// keeping only pages of the copies and instructions printed on their back.
+// The template is the pdf form of the type of return of the payee, such as pdf/2020/f1099msc.pdf.
+func FillPayee(template []byte, payer *records.ARecord, payee *records.BRecord, copies ...Copy) ([]byte, error) {
+	layout, ok := layouts[payee.TypeOfReturn()]
+	if !ok {
+		return nil, fmt.Errorf("type of return %s %w", payee.TypeOfReturn(), ErrUnsupportedForm)
+	}
+	if len(copies) == 0 {
+		copies = RecipientCopies
+	}
+
+	f, err := openForm(template)
+	if err != nil {
+		return nil, err
+	}
+
+	text, checks, err := fieldValues(layout, payer, payee)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixes := make([]string, 0, len(copies))
+	for _, c := range copies {
+		prefix := "topmostSubform[0]." + string(c) + "[0]."
+		prefixes = append(prefixes, prefix)
+		names := f.fieldsWithPrefix(prefix)
+		if len(names) == 0 {
+			return nil, fmt.Errorf("pdf form has no %s", c)
+		}
+
+		for partial, value := range text {
+			name, ok := fieldName(names, partial)
+			if !ok {
+				return nil, fmt.Errorf("pdf form has no field %s of %s", partial, c)
+			}
+			if err := f.setText(name, value); err != nil {
+				return nil, err
+			}
+		}
+		for partial, checked := range checks {
+			name, ok := fieldName(names, partial)
+			if !ok {
+				return nil, fmt.Errorf("pdf form has no field %s of %s", partial, c)
+			}
+			if err := f.setCheck(name, checked); err != nil {
+				return nil, err
+			}
+		}
+		if len(payee.CorrectedReturnIndicator) > 0 {
+			if name, ok := f.correctedBox(names); ok {
+				if err := f.setCheck(name, true); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	if err := f.keepPages(prefixes); err != nil {
+		return nil, err
+	}
+	return f.bytes(), nil
+}
+
+// FillFile fills copies of forms of all payees of the file, returning pdf files keyed by record sequence number of payees.
+// Templates of types of return are read from the directory, such as pdf/2020.
+func FillFile(f file.File, dir string, copies ...Copy) (map[int][]byte, error) {
+	buf := f.Ascii()
+	if len(buf)%config.RecordLength != 0 {
+		return nil, utils.ErrInvalidAscii
+	}
+
+	templates := make(map[string][]byte)
+	filled := make(map[int][]byte)
+	var payer *records.ARecord
+	for offset := 0; offset < len(buf); offset += config.RecordLength {
+		record := buf[offset : offset+config.RecordLength]
+		switch string(record[:1]) {
+		case config.ARecordType:
+			payer = records.NewARecord().(*records.ARecord)
+			if err := payer.Parse(record); err != nil {
+				return nil, err
+			}
+		case config.BRecordType:
+			if payer == nil {
+				return nil, utils.ErrInvalidFile
+			}
+			typeOfReturn := config.TypeOfReturns[payer.TypeOfReturn]
+			payee := records.NewBRecord(typeOfReturn).(*records.BRecord)
+			if err := payee.Parse(record); err != nil {
+				return nil, err
+			}
+
+			template, ok := templates[typeOfReturn]
+			if !ok {
+				name, ok := Templates[typeOfReturn]
+				if !ok {
+					return nil, fmt.Errorf("type of return %s %w", typeOfReturn, ErrUnsupportedForm)
+				}
+				var err error
+				if template, err = ioutil.ReadFile(filepath.Join(dir, name)); err != nil {
+					return nil, err
+				}
+				templates[typeOfReturn] = template
+			}
+
+			pdf, err := FillPayee(template, payer, payee, copies...)
+			if err != nil {
+				return nil, fmt.Errorf("payee “B” record #%d: %w", payee.SequenceNumber(), err)
+			}
+			filled[payee.SequenceNumber()] = pdf
+		}
+	}
+	return filled, nil
+}
+
+// fieldValues returns values of text fields and check boxes of the form, keyed by partial names of fields
+func fieldValues(layout formLayout, payer *records.ARecord, payee *records.BRecord) (map[string]string, map[string]bool, error) {
+	// payers are identified by their EIN
+	boxes := map[string]string{
+		boxPayer:         payerBlock(payer),
+		boxPayerTIN:      formatTIN(payer.TIN, config.TinType1),
+		boxRecipientTIN:  formatTIN(payee.TIN, payee.TypeOfTIN),
+		boxRecipientName: joinNonEmpty(" ", payee.FirstPayeeNameLine, payee.SecondPayeeNameLine),
+		boxStreetAddress: strings.TrimSpace(payee.PayeeMailingAddress),
+		boxAccountNumber: strings.TrimSpace(payee.PayerAccountNumber),
+	}
+	if payee.ForeignCountryIndicator == "1" {
+		// city, province or state, postal code and country are reported in free format
+		boxes[boxCityStateZip] = strings.TrimSpace(payee.PayeeCity)
+	} else {
+		boxes[boxCityStateZip] = cityStateZip(payee.PayeeCity, payee.PayeeState, payee.PayeeZipCode)
+	}
+	if withheld := payee.StateIncomeTaxWithheld(); withheld != 0 {
+		boxes[boxStateTaxWithheld] = formatAmount(withheld)
+	}
+	boxes[boxStateNumber] = stateAbbreviation(payee.CombinedFSCode())
+
+	text := make(map[string]string)
+	for box, value := range boxes {
+		if len(value) > 0 {
+			text[layout.text[box]] = value
+		}
+	}
+	for code, amount := range payee.PaymentAmounts() {
+		if amount == 0 {
+			continue
+		}
+		field, ok := layout.amounts[code]
+		if !ok && payee.TypeOfReturn() == config.Sub1099MiscType && code == config.MiscNecAmountCode {
+			return nil, nil, ErrNonemployeeCompensation
+		}
+		if !ok {
+			return nil, nil, fmt.Errorf("payment amount %s has no box on the form", code)
+		}
+		text[field] = formatAmount(amount)
+	}
+
+	checks := make(map[string]bool)
+	switch ext := payee.SubRecord().(type) {
+	case *subrecords.Sub1099MISC:
+		checks[layout.checks[boxFATCA]] = ext.FATCA == "1"
+		checks[layout.checks[boxDirectSales]] = ext.DirectSalesIndicator == "1"
+	case *subrecords.Sub1099NEC:
+		checks[layout.checks[boxFATCA]] = ext.FATCA == "1"
+	}
+	return text, checks, nil
+}
+
+// fieldName returns the full name of the field with the partial name
+func fieldName(names []string, partial string) (string, bool) {
+	for _, name := range names {
+		if strings.HasSuffix(name, "."+partial) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// correctedBox returns the full name of the CORRECTED check box in the header of the copy,
+// which is distinguished from the VOID check box by its export value “2”
+func (f *form) correctedBox(names []string) (string, bool) {
+	for _, name := range names {
+		field := f.fields[name]
+		if field.kind == "Btn" && strings.Contains(name, "Header") && f.onState(field) == "2" {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// payerBlock returns name, street address, city, state, ZIP code and telephone number of the payer
+func payerBlock(payer *records.ARecord) string {
+	return joinNonEmpty("\n",
+		payer.FirstPayerNameLine,
+		payer.SecondPayerNameLine,
+		payer.PayerShippingAddress,
+		cityStateZip(payer.PayerCity, payer.PayerState, payer.PayerZipCode),
+		payer.PayerTelephoneNumber,
+	)
+}
+
+func cityStateZip(city, state, zip string) string {
+	zip = strings.TrimSpace(zip)
+	if len(zip) == 9 {
+		zip = zip[:5] + "-" + zip[5:]
+	}
+	return joinNonEmpty(" ", joinNonEmpty(", ", city, state), zip)
+}
+
+// joinNonEmpty joins trimmed non-empty values with the separator
+func joinNonEmpty(sep string, values ...string) string {
+	var parts []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); len(value) > 0 {
+			parts = append(parts, value)
+		}
+	}
+	return strings.Join(parts, sep)
+}
+
+// formatTIN formats an EIN as 12-3456789 and a SSN, ITIN or ATIN as 123-45-6789
+func formatTIN(tin, typeOfTIN string) string {
+	tin = strings.TrimSpace(tin)
+	if len(tin) != 9 {
+		return tin
+	}
+	switch typeOfTIN {
+	case config.TinType1:
+		return tin[:2] + "-" + tin[2:]
+	case config.TinType2:
+		return tin[:3] + "-" + tin[3:5] + "-" + tin[5:]
+	}
+	return tin
+}
+
+// formatAmount formats an amount of cents as dollars, such as 1,234.56
+func formatAmount(cents int) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	dollars := fmt.Sprintf("%d", cents/100)
+	for i := len(dollars) - 3; i > 0; i -= 3 {
+		dollars = dollars[:i] + "," + dollars[i:]
+	}
+	return fmt.Sprintf("%s%s.%02d", sign, dollars, cents%100)
+}
+
+// stateAbbreviation returns abbreviation of the state of the CF/SF code, or empty string for unknown codes
+func stateAbbreviation(code int) string {
+	name, ok := config.ParticipateStateCodes[code]
+	if !ok {
+		return ""
+	}
+	for abbreviation, state := range config.StateAbbreviationCodes {
+		if state == name {
+			return abbreviation
+		}
+	}
+	return ""
+}
@@ -0,0 +1,155 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package pdf
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/file"
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/moov-io/irs/pkg/subrecords"
+	"github.com/stretchr/testify/require"
+)
+
+var templateDir = filepath.Join("..", "..", "pdf", "2020")
+
+func readRecords(t *testing.T, typeOfReturn string) (*records.ARecord, *records.BRecord) {
+	a := require.New(t)
+
+	buf, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "oneTransactionFile.ascii"))
+	a.Nil(err)
+	payer := records.NewARecord().(*records.ARecord)
+	a.Nil(payer.Parse(buf[config.RecordLength : 2*config.RecordLength]))
+	payee := records.NewBRecord(typeOfReturn).(*records.BRecord)
+	a.Nil(payee.Parse(buf[2*config.RecordLength : 3*config.RecordLength]))
+	return payer, payee
+}
+
+func fieldValue(t *testing.T, f *form, copy Copy, partial string) interface{} {
+	name, ok := fieldName(f.fieldsWithPrefix("topmostSubform[0]."+string(copy)+"[0]."), partial)
+	require.True(t, ok, partial)
+	switch v := f.fields[name].dict["V"].(type) {
+	case pdfString:
+		return decodeText(v)
+	default:
+		return v
+	}
+}
+
+func Test_FillPayee_1099Misc(t *testing.T) {
+	a := require.New(t)
+	payer, payee := readRecords(t, config.Sub1099MiscType)
+	// nonemployee compensation isn't reported by 1099-MISC since 2020
+	payee.PaymentAmount7 = 0
+	payee.PayerAccountNumber = "ACCOUNT1"
+	payee.SubRecord().(*subrecords.Sub1099MISC).DirectSalesIndicator = "1"
+
+	template, err := ioutil.ReadFile(filepath.Join(templateDir, "f1099msc.pdf"))
+	a.Nil(err)
+	buf, err := FillPayee(template, payer, payee)
+	a.Nil(err)
+	a.True(bytes.HasPrefix(buf, template))
+
+	f, err := openForm(buf)
+	a.Nil(err)
+	a.Nil(f.acroForm["XFA"])
+	for _, c := range RecipientCopies {
+		a.Equal("ASDF GLOBAL INC\n123 ASDF STREET\nNEW YORK, NY 10001\n5555555555", fieldValue(t, f, c, "f2_1[0]"))
+		a.Equal("12-3456789", fieldValue(t, f, c, "f2_2[0]"))
+		a.Equal("98-7654321", fieldValue(t, f, c, "f2_3[0]"))
+		a.Equal("SPACELEY SPROCKETS", fieldValue(t, f, c, "f2_4[0]"))
+		a.Equal("ACCOUNT1", fieldValue(t, f, c, "f2_7[0]"))
+		a.Equal("1.00", fieldValue(t, f, c, "f2_8[0]"))
+		a.Equal("4.00", fieldValue(t, f, c, "f2_11[0]"))
+		a.Equal("10.00", fieldValue(t, f, c, "f2_16[0]"))
+		a.Equal("14.00", fieldValue(t, f, c, "f2_21[0]"))
+		a.Equal("0.04", fieldValue(t, f, c, "f2_22[0]"))
+		a.Equal(pdfName("Yes"), fieldValue(t, f, c, "c2_2[0]"))
+		a.Equal(pdfName("Yes"), fieldValue(t, f, c, "c2_4[0]"))
+	}
+	a.Nil(fieldValue(t, f, "CopyA", "f1_1[0]"))
+
+	// copies 1, B, instructions for recipient and copy 2
+	pages := f.pages(f.doc.dict(f.doc.trailer["Root"])["Pages"], 0)
+	a.Len(pages, 4)
+	name, _ := fieldName(f.fieldsWithPrefix("topmostSubform[0].CopyB[0]."), "f2_8[0]")
+	widget := f.doc.dict(f.fields[name].widgets[0])
+	ap := f.doc.resolve(f.doc.dict(widget["AP"])["N"]).(*pdfStream)
+	a.Contains(string(ap.Data), "(1.00) Tj")
+}
+
+func Test_FillPayee_1099Nec(t *testing.T) {
+	a := require.New(t)
+	payer, payee := readRecords(t, config.Sub1099NecType)
+	payee.ClearPaymentAmounts()
+	payee.PaymentAmount1 = 123456789
+	payee.CorrectedReturnIndicator = config.CorrectedReturnIndicatorG
+
+	template, err := ioutil.ReadFile(filepath.Join(templateDir, "f1099nec.pdf"))
+	a.Nil(err)
+	buf, err := FillPayee(template, payer, payee, CopyB)
+	a.Nil(err)
+
+	f, err := openForm(buf)
+	a.Nil(err)
+	a.Equal("1,234,567.89", fieldValue(t, f, CopyB, "f2_8[0]"))
+	a.Equal(pdfName("2"), fieldValue(t, f, CopyB, "c2_1[0]"))
+	a.Nil(fieldValue(t, f, Copy2, "f2_8[0]"))
+	a.Len(f.pages(f.doc.dict(f.doc.trailer["Root"])["Pages"], 0), 2)
+
+	payee.PaymentAmount2 = 100
+	_, err = FillPayee(template, payer, payee)
+	a.NotNil(err)
+
+	payee = records.NewBRecord(config.Sub1099IntType).(*records.BRecord)
+	_, err = FillPayee(template, payer, payee)
+	a.True(strings.Contains(err.Error(), ErrUnsupportedForm.Error()))
+}
+
+func Test_FillFile(t *testing.T) {
+	a := require.New(t)
+
+	buf, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "oneTransactionFile.json"))
+	a.Nil(err)
+	f, err := file.CreateFile(buf)
+	a.Nil(err)
+	_, err = FillFile(f, templateDir)
+	a.True(errors.Is(err, ErrNonemployeeCompensation))
+	a.Equal("payee “B” record #3: payment amount 7 is nonemployee compensation, which has no box on the 1099-MISC form since box 7 moved to box 1 of 1099-NEC", err.Error())
+
+	// nonemployee compensation isn't reported by 1099-MISC since 2020
+	buf = bytes.ReplaceAll(buf, []byte(`"payment_amount_7": 700`), []byte(`"payment_amount_7": 0`))
+	f, err = file.CreateFile(buf)
+	a.Nil(err)
+	filled, err := FillFile(f, templateDir, CopyB)
+	a.Nil(err)
+	a.Len(filled, 2)
+	a.Contains(filled, 3)
+	a.Contains(filled, 4)
+}
+
+func Test_ParseObjects(t *testing.T) {
+	a := require.New(t)
+
+	p := &parser{buf: []byte(`<</Name /A#20B /Array [1 -2.5 (str\)ing) <414243> 12 0 R true null] /Dict <<>>>>`)}
+	obj, err := p.object()
+	a.Nil(err)
+	dict := obj.(pdfDict)
+	a.Equal(pdfName("A B"), dict["Name"])
+	a.Equal(pdfArray{int64(1), -2.5, pdfString("str)ing"), pdfString("ABC"), pdfRef{Number: 12}, true, nil}, dict["Array"])
+	a.Equal(pdfDict{}, dict["Dict"])
+
+	out := &bytes.Buffer{}
+	writeObject(out, dict)
+	a.Equal(`<</Array [1 -2.5 (str\)ing) (ABC) 12 0 R true null]/Dict <<>>/Name /A#20B>>`, out.String())
+
+	a.Equal("été", decodeText(textString("été")))
+}
@@ -27,3 +27,16 @@ func (t *RecordTest) TestCRecordWithError(c *check.C) {
 	err := r.Parse(t.cRecordAscii[1:])
 	c.Assert(err, check.Not(check.IsNil))
 }
+
+func (t *RecordTest) TestCRecordWithUnreportedAmountCodes(c *check.C) {
+	r := &CRecord{}
+	err := json.Unmarshal(t.cRecordJson, r)
+	c.Assert(err, check.IsNil)
+
+	// totals of amount codes that aren't reported by the payer are zero
+	r.ControlTotal2, r.ControlTotal3, r.ControlTotalG = 0, 0, 0
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(r.Ascii())
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+}
@@ -274,15 +274,19 @@ func (r *BRecord) Ascii() []byte {
 
 // Validate performs some checks on the record and returns an error if not Validated
 func (r *BRecord) Validate() error {
-	err := utils.Validate(r, config.BRecordLayout)
-	if err != nil {
-		return err
-	}
+	report := &utils.ValidationReport{}
+	report.Add(r, utils.Validate(r, config.BRecordLayout))
+	report.Add(r, r.validateNegativeAmounts())
 	if r.extRecord == nil {
-		return utils.ErrPayeeExtBlock
+		report.Add(r, utils.ErrPayeeExtBlock)
+	} else {
+		report.AddExtension(r, r.extRecord.Validate(), config.RecordLength-config.SubRecordLength)
+		if validator, ok := r.extRecord.(subrecords.AmountValidator); ok {
+			report.Add(r, validator.ValidateAmounts(r.PaymentAmounts()))
+		}
 	}
 
-	return r.extRecord.Validate()
+	return report.Err()
 }
 
 // SequenceNumber returns sequence number of the record
@@ -306,6 +310,60 @@ func (r *BRecord) TypeOfReturn() string {
 	return r.typeOfReturn
 }
 
+// SubRecord returns the extension block of the record, or nil if type of return isn't set
+func (r *BRecord) SubRecord() subrecords.SubRecord {
+	return r.extRecord
+}
+
+// PaymentAmounts returns payment amounts of the record keyed by amount code
+func (r *BRecord) PaymentAmounts() map[string]int {
+	amounts := make(map[string]int)
+	fields := reflect.ValueOf(r).Elem()
+	for _, code := range config.PaymentAmountCodes {
+		amounts[code] = int(fields.FieldByName("PaymentAmount" + code).Int())
+	}
+	return amounts
+}
+
+// ClearPaymentAmounts sets all payment amounts of the record to zero
+func (r *BRecord) ClearPaymentAmounts() {
+	fields := reflect.ValueOf(r).Elem()
+	for _, code := range config.PaymentAmountCodes {
+		fields.FieldByName("PaymentAmount" + code).SetInt(0)
+	}
+}
+
+// ClearStateReporting sets state and local income tax withheld and CF/SF code of the extension block to zero,
+// so the record isn't reported for the CF/SF Program
+func (r *BRecord) ClearStateReporting() {
+	if r.extRecord == nil {
+		return
+	}
+	fields := reflect.ValueOf(r.extRecord).Elem()
+	for _, name := range []string{"StateIncomeTaxWithheld", "LocalIncomeTaxWithheld", "CombinedFSCode"} {
+		field := fields.FieldByName(name)
+		if field.IsValid() && field.Kind() == reflect.Int {
+			field.SetInt(0)
+		}
+	}
+}
+
+// CombinedFSCode returns CF/SF code of the extension block,
+// or zero if the payee isn't reported for the CF/SF Program
+func (r *BRecord) CombinedFSCode() int {
+	return r.extIntField("CombinedFSCode")
+}
+
+// StateIncomeTaxWithheld returns state income tax withheld of the extension block
+func (r *BRecord) StateIncomeTaxWithheld() int {
+	return r.extIntField("StateIncomeTaxWithheld")
+}
+
+// LocalIncomeTaxWithheld returns local income tax withheld of the extension block
+func (r *BRecord) LocalIncomeTaxWithheld() int {
+	return r.extIntField("LocalIncomeTaxWithheld")
+}
+
 // Marshal returns the JSON encoding
 func (r *BRecord) MarshalJSON() ([]byte, error) {
 	type recordJson BRecord
@@ -356,6 +414,35 @@ func (r *BRecord) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, r.extRecord)
 }
 
+// extIntField returns integer field of the extension block, or zero if the field doesn’t exist
+func (r *BRecord) extIntField(name string) int {
+	if r.extRecord == nil {
+		return 0
+	}
+	field := reflect.ValueOf(r.extRecord).Elem().FieldByName(name)
+	if !field.IsValid() || field.Kind() != reflect.Int {
+		return 0
+	}
+	return int(field.Int())
+}
+
+// validateNegativeAmounts checks that negative payment amounts are reported only for types of return reflecting a loss
+func (r *BRecord) validateNegativeAmounts() error {
+	if config.NegativeAmountReturns[r.typeOfReturn] {
+		return nil
+	}
+
+	report := &utils.ValidationReport{}
+	amounts := r.PaymentAmounts()
+	for _, code := range config.PaymentAmountCodes {
+		if amounts[code] < 0 {
+			name := "PaymentAmount" + code
+			report.Add(r, utils.NewFieldError(name, config.BRecordLayout[name], amounts[code], utils.ErrNegativeAmount))
+		}
+	}
+	return report.Err()
+}
+
 // customized field validation functions
 // function name should be "Validate" + field name
 
@@ -9,6 +9,8 @@ import (
 	"gopkg.in/check.v1"
 
 	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/subrecords"
+	"github.com/moov-io/irs/pkg/utils"
 )
 
 func (t *RecordTest) TestBRecordWith1099MISC(c *check.C) {
@@ -25,6 +27,59 @@ func (t *RecordTest) TestBRecordWith1099MISC(c *check.C) {
 	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099MiscAscii))
 }
 
+func (t *RecordTest) TestBRecordWith1099NEC(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099NecType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1099NecJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099NecAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1099NecAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099NecAscii))
+}
+
+func (t *RecordTest) TestBRecordWithoutCombinedFSCode(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099MiscType)
+	err := json.Unmarshal(t.bRecord1099MiscJson, r)
+	c.Assert(err, check.IsNil)
+
+	// payees not reported for the CF/SF program have a blank code
+	ext := r.extRecord.(*subrecords.Sub1099MISC)
+	ext.CombinedFSCode = 0
+	c.Assert(r.Validate(), check.IsNil)
+	buf := r.Ascii()
+	c.Assert(string(buf[746:748]), check.Equals, "  ")
+	err = r.Parse(buf)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.CombinedFSCode(), check.Equals, 0)
+
+	ext = r.extRecord.(*subrecords.Sub1099MISC)
+	ext.CombinedFSCode = 2
+	err = r.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	c.Assert(err.(*utils.ValidationReport).Errors[0].FieldName, check.Equals, "CombinedFSCode")
+}
+
+func (t *RecordTest) TestBRecordWithUnreportedAmountCodes(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099NecType)
+	err := json.Unmarshal(t.bRecord1099NecJson, r)
+	c.Assert(err, check.IsNil)
+
+	// a 1099-NEC payee reports nonemployee compensation only, other payment amounts are zero
+	r.ClearPaymentAmounts()
+	r.PaymentAmount1 = 500000
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(r.Ascii())
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(r.PaymentAmount4, check.Equals, 0)
+}
+
 func (t *RecordTest) TestBRecordWithError(c *check.C) {
 	r := &BRecord{}
 	err := r.Parse(t.bRecord1099MiscAscii[1:])
@@ -86,3 +141,508 @@ func (t *RecordTest) TestBRecordWith1097Btc(c *check.C) {
 	c.Assert(r.Validate(), check.IsNil)
 	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1097BtcAscii))
 }
+
+func (t *RecordTest) TestBRecordWith1099DIV(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099DivType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1099DivJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099DivAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1099DivAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099DivAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1099DIVAmounts(c *check.C) {
+	r := NewBRecord(config.Sub1099DivType).(*BRecord)
+	err := json.Unmarshal(t.bRecord1099DivJson, r)
+	c.Assert(err, check.IsNil)
+	r.PaymentAmount2 = r.PaymentAmount1 + 1
+	r.PaymentAmount5 = r.PaymentAmount1 + 1
+	err = r.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 2)
+	c.Assert(report.Errors[0].FieldName, check.Equals, "PaymentAmount2")
+	c.Assert(report.Errors[0].Code, check.Equals, utils.CodeAmountExceeded)
+	c.Assert(report.Errors[1].Error(), check.Equals, "B record #3 PaymentAmount5 (columns 103-114): is greater than total ordinary dividends")
+}
+
+func (t *RecordTest) TestBRecordWith1099B(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099BType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1099BJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099BAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1099BAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099BAscii))
+}
+
+func (t *RecordTest) TestBRecordWithNegativeAmounts(c *check.C) {
+	r := NewBRecord(config.Sub1099BType).(*BRecord)
+	err := json.Unmarshal(t.bRecord1099BJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.PaymentAmount9 < 0, check.Equals, true)
+	c.Assert(r.Validate(), check.IsNil)
+
+	r = NewBRecord(config.Sub1099NecType).(*BRecord)
+	err = json.Unmarshal(t.bRecord1099NecJson, r)
+	c.Assert(err, check.IsNil)
+	r.PaymentAmount1 = -100
+	err = r.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 1)
+	c.Assert(report.Errors[0].Code, check.Equals, utils.CodeNegativeAmount)
+	c.Assert(report.Errors[0].FieldName, check.Equals, "PaymentAmount1")
+}
+
+func (t *RecordTest) TestBRecordWith1099BDates(c *check.C) {
+	r := NewBRecord(config.Sub1099BType).(*BRecord)
+	err := json.Unmarshal(t.bRecord1099BJson, r)
+	c.Assert(err, check.IsNil)
+	ext := r.extRecord.(*subrecords.Sub1099B)
+	ext.DateAcquired = "20201301"
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	ext.DateAcquired = "20200401"
+	err = r.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 1)
+	c.Assert(report.Errors[0].FieldName, check.Equals, "DateAcquired")
+}
+
+func (t *RecordTest) TestBRecordWith1099R(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099RType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1099RJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099RAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1099RAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099RAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1099RDistributionCodes(c *check.C) {
+	r := NewBRecord(config.Sub1099RType).(*BRecord)
+	err := json.Unmarshal(t.bRecord1099RJson, r)
+	c.Assert(err, check.IsNil)
+	ext := r.extRecord.(*subrecords.Sub1099R)
+	for _, code := range []string{"7", "1B", "B1", "4G", "6W", "D ", "8J"} {
+		ext.DistributionCode = code
+		c.Assert(r.Validate(), check.IsNil, check.Commentf("distribution code %s", code))
+	}
+	for _, code := range []string{"5B", "7C", "Z", "1I", "33"} {
+		ext.DistributionCode = code
+		err = r.Validate()
+		c.Assert(err, check.Not(check.IsNil), check.Commentf("distribution code %s", code))
+		report := err.(*utils.ValidationReport)
+		c.Assert(report.Errors, check.HasLen, 1)
+		c.Assert(report.Errors[0].FieldName, check.Equals, "DistributionCode")
+	}
+	ext.DistributionCode = ""
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+}
+
+func (t *RecordTest) TestBRecordWith1099K(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099KType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1099KJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099KAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1099KAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099KAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1099KAmounts(c *check.C) {
+	r := NewBRecord(config.Sub1099KType).(*BRecord)
+	err := json.Unmarshal(t.bRecord1099KJson, r)
+	c.Assert(err, check.IsNil)
+	r.PaymentAmountG++
+	err = r.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 1)
+	c.Assert(report.Errors[0].Code, check.Equals, utils.CodeUnexpectedTotal)
+	c.Assert(report.Errors[0].Error(), check.Equals, "B record #3 PaymentAmount1 (columns 55-66): has unexpected total of monthly payments (expected 7801, got 7800)")
+}
+
+func (t *RecordTest) TestBRecordWith1098(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1098Type)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1098Json, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1098Ascii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1098Ascii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1098Ascii))
+}
+
+func (t *RecordTest) TestBRecordWith1098C(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1098CType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1098CJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1098CAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1098CAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1098CAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1098E(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1098EType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1098EJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1098EAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1098EAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1098EAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1098F(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1098FType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1098FJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1098FAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1098FAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1098FAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1098Q(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1098QType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1098QJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1098QAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1098QAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1098QAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1098T(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1098TType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1098TJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1098TAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1098TAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1098TAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1098Rules(c *check.C) {
+	r := NewBRecord(config.Sub1098CType).(*BRecord)
+	err := json.Unmarshal(t.bRecord1098CJson, r)
+	c.Assert(err, check.IsNil)
+	r.extRecord.(*subrecords.Sub1098C).DateOfSale = "20200101"
+	err = r.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	c.Assert(err.(*utils.ValidationReport).Errors[0].FieldName, check.Equals, "DateOfSale")
+
+	r = NewBRecord(config.Sub1098FType).(*BRecord)
+	err = json.Unmarshal(t.bRecord1098FJson, r)
+	c.Assert(err, check.IsNil)
+	r.extRecord.(*subrecords.Sub1098F).PaymentCode = "ADA"
+	err = r.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	c.Assert(err.(*utils.ValidationReport).Errors[0].FieldName, check.Equals, "PaymentCode")
+	r.extRecord.(*subrecords.Sub1098F).PaymentCode = "ADZ"
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+}
+
+func (t *RecordTest) TestBRecordWith5498(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub5498Type)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord5498Json, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord5498Ascii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord5498Ascii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord5498Ascii))
+}
+
+func (t *RecordTest) TestBRecordWith5498ESA(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub5498EsaType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord5498EsaJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord5498EsaAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord5498EsaAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord5498EsaAscii))
+}
+
+func (t *RecordTest) TestBRecordWith5498SA(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub5498SaType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord5498SaJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord5498SaAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord5498SaAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord5498SaAscii))
+}
+
+func (t *RecordTest) TestBRecordWith5498Amounts(c *check.C) {
+	r := NewBRecord(config.Sub5498Type).(*BRecord)
+	err := json.Unmarshal(t.bRecord5498Json, r)
+	c.Assert(err, check.IsNil)
+	r.extRecord.(*subrecords.Sub5498).PostponedContributionCode = ""
+	r.PaymentAmountD = 100
+	err = r.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 2)
+	c.Assert(report.Errors[0].Error(), check.Equals, "B record #3 PaymentAmountC (columns 187-198): is required field (PostponedContributionCode)")
+	c.Assert(report.Errors[1].FieldName, check.Equals, "PaymentAmountD")
+	c.Assert(report.Errors[1].Code, check.Equals, utils.CodeFieldRequired)
+}
+
+func (t *RecordTest) TestBRecordWithW2G(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.SubW2GType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecordW2GJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecordW2GAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecordW2GAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecordW2GAscii))
+}
+
+func (t *RecordTest) TestBRecordWithW2GTypeOfWager(c *check.C) {
+	r := NewBRecord(config.SubW2GType).(*BRecord)
+	err := json.Unmarshal(t.bRecordW2GJson, r)
+	c.Assert(err, check.IsNil)
+	r.extRecord.(*subrecords.SubW2G).TypeOfWager = "0"
+	err = r.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	c.Assert(err.Error(), check.Equals, "B record #3 TypeOfWager (columns 547-547): is an invalid value of type of wager code")
+}
+
+func (t *RecordTest) TestBRecordWith1099A(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099AType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1099AJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099AAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1099AAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099AAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1099C(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099CType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1099CJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099CAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1099CAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099CAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1099S(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099SType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1099SJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099SAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1099SAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099SAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1099CIdentifiableEventCode(c *check.C) {
+	r := NewBRecord(config.Sub1099CType).(*BRecord)
+	err := json.Unmarshal(t.bRecord1099CJson, r)
+	c.Assert(err, check.IsNil)
+	r.extRecord.(*subrecords.Sub1099C).IdentifiableEventCode = "Z"
+	err = r.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	c.Assert(err.Error(), check.Equals, "B record #3 IdentifiableEventCode (columns 547-547): is an invalid value of identifiable event code")
+}
+
+func (t *RecordTest) TestBRecordWith1099G(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099GType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1099GJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099GAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1099GAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099GAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1099Q(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099QType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1099QJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099QAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1099QAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099QAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1099SA(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099SaType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1099SaJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099SaAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1099SaAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099SaAscii))
+}
+
+func (t *RecordTest) TestBRecordWith1099LTC(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub1099LtcType)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord1099LtcJson, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099LtcAscii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord1099LtcAscii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord1099LtcAscii))
+}
+
+func (t *RecordTest) TestBRecordWithCodeTables(c *check.C) {
+	r := NewBRecord(config.Sub1099SaType).(*BRecord)
+	err := json.Unmarshal(t.bRecord1099SaJson, r)
+	c.Assert(err, check.IsNil)
+	r.extRecord.(*subrecords.Sub1099SA).DistributionCode = "7"
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+
+	r = NewBRecord(config.Sub1099QType).(*BRecord)
+	err = json.Unmarshal(t.bRecord1099QJson, r)
+	c.Assert(err, check.IsNil)
+	r.extRecord.(*subrecords.Sub1099Q).TypeOfTuitionPayment = "4"
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+
+	r = NewBRecord(config.Sub1099LtcType).(*BRecord)
+	err = json.Unmarshal(t.bRecord1099LtcJson, r)
+	c.Assert(err, check.IsNil)
+	ext := r.extRecord.(*subrecords.Sub1099LTC)
+	ext.TypeOfPayment = "3"
+	ext.StatusOfIllness = ""
+	err = r.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 2)
+	c.Assert(report.Errors[0].FieldName, check.Equals, "TypeOfPayment")
+	c.Assert(report.Errors[1].FieldName, check.Equals, "DateCertified")
+}
+
+func (t *RecordTest) TestBRecordWith3921(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub3921Type)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord3921Json, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord3921Ascii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord3921Ascii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord3921Ascii))
+}
+
+func (t *RecordTest) TestBRecordWith3922(c *check.C) {
+	r := &BRecord{}
+	r.SetTypeOfReturn(config.Sub3922Type)
+	c.Assert(r.Validate(), check.Not(check.IsNil))
+	err := json.Unmarshal(t.bRecord3922Json, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord3922Ascii))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(t.bRecord3922Ascii)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.Validate(), check.IsNil)
+	c.Assert(string(r.Ascii()), check.Equals, string(t.bRecord3922Ascii))
+}
+
+func (t *RecordTest) TestBRecordWith3921DateOrdering(c *check.C) {
+	r := NewBRecord(config.Sub3921Type).(*BRecord)
+	err := json.Unmarshal(t.bRecord3921Json, r)
+	c.Assert(err, check.IsNil)
+	c.Assert(string(r.Ascii()[562:570]), check.Equals, "00001000")
+	ext := r.extRecord.(*subrecords.Sub3921)
+	ext.DateOptionExercised = "20160201"
+	ext.NumberShares = 0
+	r.PaymentAmount3 = 0
+	err = r.Validate()
+	c.Assert(err, check.Not(check.IsNil))
+	report := err.(*utils.ValidationReport)
+	c.Assert(report.Errors, check.HasLen, 3)
+	c.Assert(report.Errors[0].Error(), check.Equals, "B record #3 DateOptionExercised (columns 555-562): is an invalid value of date option exercised (before date option granted)")
+	c.Assert(report.Errors[1].FieldName, check.Equals, "NumberShares")
+	c.Assert(report.Errors[2].FieldName, check.Equals, "PaymentAmount3")
+}
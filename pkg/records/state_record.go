@@ -69,16 +69,16 @@ type KRecord struct {
 	// Aggregate totals of the state income tax withheld field in the
 	// Payee “B” Records. Otherwise, enter blanks. (This field is for
 	// the convenience of filers.)
-	StateIncomeTaxWithheldTotal string `json:"state_income_tax_withheld_total"`
+	StateIncomeTaxWithheldTotal int `json:"state_income_tax_withheld_total"`
 
 	// Aggregate totals of the local income tax withheld field in the
 	// Payee “B” Records. Otherwise, enter blanks. (This field is for
 	// the convenience of filers.)
-	LocalIncomeTaxWithheldTotal string `json:"local_income_tax_withheld_total"`
+	LocalIncomeTaxWithheldTotal int `json:"local_income_tax_withheld_total"`
 
 	// Required. Enter the CF/SF code assigned to the state which
 	// is to receive the information.
-	CombinedFederalStateCode string `json:"combined_federal_state_code" validate:"required"`
+	CombinedFederalStateCode int `json:"combined_federal_state_code" validate:"required"`
 }
 
 // Type returns type of “K” record
@@ -145,7 +145,7 @@ func (r *KRecord) ValidateSequenceNumber() error {
 }
 
 func (r *KRecord) ValidateCombinedFederalStateCode() error {
-	if _, ok := config.StateAbbreviationCodes[r.CombinedFederalStateCode]; ok {
+	if _, ok := config.ParticipateStateCodes[r.CombinedFederalStateCode]; ok {
 		return nil
 	}
 	return utils.NewErrValidValue("combined federal state code")
@@ -6,6 +6,8 @@ package records
 
 import (
 	"encoding/json"
+	"strings"
+
 	"gopkg.in/check.v1"
 )
 
@@ -27,3 +29,19 @@ func (t *RecordTest) TestKRecordWithError(c *check.C) {
 	err := r.Parse(t.kRecordAscii[1:])
 	c.Assert(err, check.Not(check.IsNil))
 }
+
+func (t *RecordTest) TestKRecordWithoutWithholding(c *check.C) {
+	r := &KRecord{}
+	err := json.Unmarshal(t.kRecordJson, r)
+	c.Assert(err, check.IsNil)
+	r.StateIncomeTaxWithheldTotal = 0
+	r.LocalIncomeTaxWithheldTotal = 0
+	buf := r.Ascii()
+	c.Assert(string(buf[706:742]), check.Equals, strings.Repeat(" ", 36))
+	c.Assert(r.Validate(), check.IsNil)
+	err = r.Parse(buf)
+	c.Assert(err, check.IsNil)
+	c.Assert(r.StateIncomeTaxWithheldTotal, check.Equals, 0)
+	c.Assert(r.LocalIncomeTaxWithheldTotal, check.Equals, 0)
+	c.Assert(string(r.Ascii()), check.Equals, string(buf))
+}
@@ -7,14 +7,16 @@ package service
 import (
 	"context"
 	"database/sql"
-	"io/ioutil"
-	"path/filepath"
 
 	"github.com/gorilla/mux"
+	"github.com/markbates/pkger"
 	"github.com/moov-io/identity/pkg/config"
 	"github.com/moov-io/identity/pkg/database"
 	"github.com/moov-io/identity/pkg/logging"
 	"github.com/moov-io/identity/pkg/stime"
+	"github.com/moov-io/irs/pkg/api"
+	"github.com/moov-io/irs/pkg/files"
+	"github.com/moov-io/irs/pkg/storage"
 	tmw "github.com/moov-io/tumbler/pkg/middleware"
 	"github.com/moov-io/tumbler/pkg/webkeys"
 )
@@ -25,6 +27,7 @@ type Environment struct {
 	Config       *Config
 	TimeService  *stime.TimeService
 	GatewayKeys  webkeys.WebKeysService
+	Repository   storage.Repository
 	PublicRouter *mux.Router
 	Shutdown     func()
 }
@@ -52,7 +55,10 @@ func NewEnvironment(env *Environment) (*Environment, error) {
 		close()
 		return nil, err
 	}
-	_ = db // delete once used.
+
+	if env.Repository == nil {
+		env.Repository = storage.NewFileRepository(db)
+	}
 
 	if env.TimeService == nil {
 		t := stime.NewSystemTimeService()
@@ -72,6 +78,8 @@ func NewEnvironment(env *Environment) (*Environment, error) {
 
 	env.PublicRouter.Use(GatewayMiddleware.Handler)
 
+	api.AppendRouters(env.Logger, env.PublicRouter, files.NewFilesController(env.Repository))
+
 	env.Shutdown = func() {
 		close()
 	}
@@ -96,13 +104,14 @@ func initializeDatabase(logger logging.Logger, config database.DatabaseConfig) (
 		}
 	}
 
-	backupFiles, _ := ioutil.ReadDir(filepath.Join("migrations"))
-	if len(backupFiles) > 0 {
+	// migrations are bundled into the binary by running pkger before building, as make does,
+	// otherwise they are read from the migrations directory of the module when running from its source tree
+	if _, err := pkger.Stat(pkger.Include("/migrations/")); err == nil {
 		if err := database.RunMigrations(logger, db, config); err != nil {
 			return nil, shutdown, logger.Fatal().LogError("Error running migrations", err)
 		}
 	} else {
-		logger.Info().Log("there is no backup files of database")
+		logger.Info().Log("there are no migrations of the database, the database must be migrated before storing files")
 	}
 
 	logger.Info().Log("finished initializing db")
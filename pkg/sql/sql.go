@@ -0,0 +1,489 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+// Package sql writes FIRE files as SQL scripts creating relational tables of records and inserting all records,
+// so that filings can be loaded into databases such as SQLite and MySQL.
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/file"
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/moov-io/irs/pkg/subrecords"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+// ErrUnsupportedDialect is given for unknown SQL dialects
+var ErrUnsupportedDialect = errors.New("unsupported sql dialect")
+
+// Dialect identifies the database that SQL statements are written for
+type Dialect string
+
+const (
+	SQLite Dialect = "sqlite"
+	MySQL  Dialect = "mysql"
+)
+
+// Names of tables of records
+const (
+	TransmittersTable    = "fire_transmitters"
+	PayersTable          = "fire_payers"
+	PayeesTable          = "fire_payees"
+	EndPayersTable       = "fire_end_payers"
+	StatesTable          = "fire_states"
+	EndTransmittersTable = "fire_end_transmitters"
+)
+
+// names of key columns
+const (
+	columnFileID              = "file_id"
+	columnSequenceNumber      = "record_sequence_number"
+	columnPayerSequenceNumber = "payer_sequence_number"
+)
+
+// ExtensionTable returns name of the table of extension blocks of payee “B” records of the type of return,
+// such as fire_payees_1099_misc for 1099-MISC
+func ExtensionTable(typeOfReturn string) string {
+	name := strings.ToLower(strings.ReplaceAll(typeOfReturn, "-", "_"))
+	return PayeesTable + "_" + name
+}
+
+// table describes a table of records, with columns of fields of the record layout
+type table struct {
+	name   string
+	layout map[string]config.SpecField
+	// record of the table, whose fields are read by names of the layout
+	record interface{}
+	// key columns preceding columns of fields, the first key columns are the primary key
+	keys       []string
+	primaryKey []string
+	references []reference
+}
+
+// reference is a foreign key of the table
+type reference struct {
+	columns []string
+	table   string
+	// referenced columns of the table
+	keys []string
+}
+
+var (
+	fileKey   = []string{columnFileID}
+	recordKey = []string{columnFileID, columnSequenceNumber}
+	payerKey  = []string{columnFileID, columnPayerSequenceNumber}
+
+	transmitterReference = reference{columns: fileKey, table: TransmittersTable, keys: fileKey}
+	payerReference       = reference{columns: payerKey, table: PayersTable, keys: recordKey}
+)
+
+var tables = map[string]table{
+	config.TRecordType: {
+		name:       TransmittersTable,
+		layout:     config.TRecordLayout,
+		record:     records.NewTRecord(),
+		keys:       fileKey,
+		primaryKey: fileKey,
+	},
+	config.ARecordType: {
+		name:       PayersTable,
+		layout:     config.ARecordLayout,
+		record:     records.NewARecord(),
+		keys:       fileKey,
+		primaryKey: recordKey,
+		references: []reference{transmitterReference},
+	},
+	config.BRecordType: {
+		name:       PayeesTable,
+		layout:     config.BRecordLayout,
+		record:     records.NewBRecord(""),
+		keys:       payerKey,
+		primaryKey: recordKey,
+		references: []reference{payerReference},
+	},
+	config.CRecordType: {
+		name:       EndPayersTable,
+		layout:     config.CRecordLayout,
+		record:     records.NewCRecord(),
+		keys:       payerKey,
+		primaryKey: recordKey,
+		references: []reference{payerReference},
+	},
+	config.KRecordType: {
+		name:       StatesTable,
+		layout:     config.KRecordLayout,
+		record:     records.NewKRecord(),
+		keys:       payerKey,
+		primaryKey: recordKey,
+		references: []reference{payerReference},
+	},
+	config.FRecordType: {
+		name:       EndTransmittersTable,
+		layout:     config.FRecordLayout,
+		record:     records.NewFRecord(),
+		keys:       fileKey,
+		primaryKey: fileKey,
+		references: []reference{transmitterReference},
+	},
+}
+
+// tables of records in order of creation, referenced tables are created first
+var tableOrder = []string{
+	config.TRecordType,
+	config.ARecordType,
+	config.BRecordType,
+	config.CRecordType,
+	config.KRecordType,
+	config.FRecordType,
+}
+
+// extensionTable returns the table of extension blocks of payee “B” records of the type of return
+func extensionTable(typeOfReturn string) (table, bool) {
+	layout, ok := config.SubRecordLayouts[typeOfReturn]
+	record := subrecords.NewSubRecord(typeOfReturn)
+	if !ok || record == nil {
+		return table{}, false
+	}
+	return table{
+		name:       ExtensionTable(typeOfReturn),
+		layout:     layout,
+		record:     record,
+		keys:       recordKey,
+		primaryKey: recordKey,
+		references: []reference{{columns: recordKey, table: PayeesTable, keys: recordKey}},
+	}, true
+}
+
+// column is a column of a field of the record layout
+type column struct {
+	name     string
+	field    string
+	kind     reflect.Kind
+	length   int
+	required bool
+}
+
+// columns returns columns of fields of the layout that are fields of the record, in order of the layout
+func (t table) columns() []column {
+	recordType := reflect.Indirect(reflect.ValueOf(t.record)).Type()
+	var columns []column
+	for _, spec := range config.ToSpecifications(t.layout) {
+		// blank fields aren't fields of records
+		field, ok := recordType.FieldByName(spec.Name)
+		if !ok {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if len(name) == 0 || name == "-" {
+			continue
+		}
+		columns = append(columns, column{
+			name:     name,
+			field:    spec.Name,
+			kind:     field.Type.Kind(),
+			length:   spec.Field.Length,
+			required: spec.Field.Required == config.Required,
+		})
+	}
+	return columns
+}
+
+// Column is a column of a field of a record layout
+type Column struct {
+	// Name of the column, the JSON name of the field
+	Name string
+	// Field is the name of the field of the record
+	Field string
+}
+
+// Columns returns columns of fields of the record, or of the extension block of payee “B” records,
+// in order of the record layout. Columns are the same as columns of tables created by CreateTables.
+func Columns(record interface{}) ([]Column, error) {
+	var t table
+	var ok bool
+	switch r := record.(type) {
+	case records.Record:
+		t, ok = tables[r.Type()]
+	case subrecords.SubRecord:
+		t, ok = extensionTable(r.Type())
+	}
+	if !ok {
+		return nil, fmt.Errorf("unexpected record %T", record)
+	}
+
+	var columns []Column
+	for _, c := range t.columns() {
+		columns = append(columns, Column{Name: c.name, Field: c.field})
+	}
+	return columns, nil
+}
+
+// quote returns the quoted identifier
+func (d Dialect) quote(name string) string {
+	if d == MySQL {
+		return "`" + name + "`"
+	}
+	return `"` + name + `"`
+}
+
+// literal returns the string literal of the value
+func (d Dialect) literal(value string) string {
+	if d == MySQL {
+		value = strings.ReplaceAll(value, `\`, `\\`)
+	}
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
+
+// columnType returns the type of the column
+func (d Dialect) columnType(c column) string {
+	if c.kind == reflect.Int {
+		if d == MySQL {
+			return "BIGINT"
+		}
+		return "INTEGER"
+	}
+	return fmt.Sprintf("VARCHAR(%d)", c.length)
+}
+
+func (d Dialect) quoteAll(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = d.quote(name)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func (d Dialect) validate() error {
+	if d != SQLite && d != MySQL {
+		return fmt.Errorf("%w %q", ErrUnsupportedDialect, d)
+	}
+	return nil
+}
+
+// createTable writes the statement creating the table if it doesn't exist
+func (d Dialect) createTable(w io.Writer, t table) error {
+	var lines []string
+	for _, key := range t.keys {
+		keyType := d.columnType(column{kind: reflect.Int})
+		if key == columnFileID {
+			keyType = "VARCHAR(64)"
+		}
+		lines = append(lines, fmt.Sprintf("  %s %s NOT NULL", d.quote(key), keyType))
+	}
+	for _, c := range t.columns() {
+		if containsString(t.keys, c.name) {
+			continue
+		}
+		line := fmt.Sprintf("  %s %s", d.quote(c.name), d.columnType(c))
+		if c.required {
+			line += " NOT NULL"
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, fmt.Sprintf("  PRIMARY KEY (%s)", d.quoteAll(t.primaryKey)))
+	for _, ref := range t.references {
+		lines = append(lines, fmt.Sprintf("  FOREIGN KEY (%s) REFERENCES %s (%s)",
+			d.quoteAll(ref.columns), d.quote(ref.table), d.quoteAll(ref.keys)))
+	}
+
+	options := ""
+	if d == MySQL {
+		options = " ENGINE=InnoDB DEFAULT CHARSET=utf8mb4"
+	}
+	_, err := fmt.Fprintf(w, "CREATE TABLE IF NOT EXISTS %s (\n%s\n)%s;\n\n", d.quote(t.name), strings.Join(lines, ",\n"), options)
+	return err
+}
+
+// insert writes the statement inserting the record with values of key columns
+func (d Dialect) insert(w io.Writer, t table, record interface{}, keys ...interface{}) error {
+	names := append([]string{}, t.keys...)
+	var values []string
+	for _, key := range keys {
+		values = append(values, d.value(key))
+	}
+
+	fields := reflect.Indirect(reflect.ValueOf(record))
+	for _, c := range t.columns() {
+		if containsString(t.keys, c.name) {
+			continue
+		}
+		names = append(names, c.name)
+		values = append(values, d.value(fields.FieldByName(c.field).Interface()))
+	}
+
+	_, err := fmt.Fprintf(w, "INSERT INTO %s (%s) VALUES (%s);\n", d.quote(t.name), d.quoteAll(names), strings.Join(values, ", "))
+	return err
+}
+
+// value returns the SQL literal of the value
+func (d Dialect) value(v interface{}) string {
+	switch value := v.(type) {
+	case int:
+		return fmt.Sprintf("%d", value)
+	case string:
+		return d.literal(value)
+	default:
+		return d.literal(fmt.Sprint(value))
+	}
+}
+
+// CreateTables writes statements creating tables of all general records and extension blocks of the types of return,
+// such as “1099-MISC”. Tables that already exist aren't changed.
+func CreateTables(w io.Writer, d Dialect, typesOfReturn ...string) error {
+	if err := d.validate(); err != nil {
+		return err
+	}
+	for _, recordType := range tableOrder {
+		if err := d.createTable(w, tables[recordType]); err != nil {
+			return err
+		}
+	}
+
+	sorted := append([]string{}, typesOfReturn...)
+	sort.Strings(sorted)
+	for i, typeOfReturn := range sorted {
+		if i > 0 && sorted[i-1] == typeOfReturn {
+			continue
+		}
+		t, ok := extensionTable(typeOfReturn)
+		if !ok {
+			return utils.NewErrValidValue("type of return")
+		}
+		if err := d.createTable(w, t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InsertRecords writes statements inserting all records of the file, identified by the file ID in all tables,
+// in a single transaction. Records are keyed by the file ID and their sequence numbers,
+// and payee “B”, end of payer “C” and state totals “K” records reference their payer “A” records.
+func InsertRecords(w io.Writer, d Dialect, fileID string, f file.File) error {
+	if err := d.validate(); err != nil {
+		return err
+	}
+	parsed, err := parseRecords(f)
+	if err != nil {
+		return err
+	}
+
+	begin := "BEGIN TRANSACTION;\n"
+	if d == MySQL {
+		begin = "START TRANSACTION;\n"
+	}
+	if _, err := io.WriteString(w, begin); err != nil {
+		return err
+	}
+	for _, r := range parsed {
+		t := tables[r.record.Type()]
+		keys := []interface{}{fileID}
+		if len(t.keys) > 1 {
+			keys = append(keys, r.payer)
+		}
+		if err := d.insert(w, t, r.record, keys...); err != nil {
+			return err
+		}
+
+		payee, ok := r.record.(*records.BRecord)
+		if !ok || payee.SubRecord() == nil {
+			continue
+		}
+		ext, ok := extensionTable(r.typeOfReturn)
+		if !ok {
+			continue
+		}
+		if err := d.insert(w, ext, payee.SubRecord(), fileID, payee.SequenceNumber()); err != nil {
+			return err
+		}
+	}
+	_, err = io.WriteString(w, "COMMIT;\n")
+	return err
+}
+
+// Export writes statements creating tables of records of the file and inserting all records of the file
+func Export(w io.Writer, d Dialect, fileID string, f file.File) error {
+	parsed, err := parseRecords(f)
+	if err != nil {
+		return err
+	}
+	var typesOfReturn []string
+	for _, r := range parsed {
+		if r.record.Type() == config.ARecordType {
+			if _, ok := config.SubRecordLayouts[r.typeOfReturn]; ok {
+				typesOfReturn = append(typesOfReturn, r.typeOfReturn)
+			}
+		}
+	}
+
+	if err := CreateTables(w, d, typesOfReturn...); err != nil {
+		return err
+	}
+	return InsertRecords(w, d, fileID, f)
+}
+
+// parsedRecord is a record of the file with sequence number and type of return of its payer
+type parsedRecord struct {
+	record       records.Record
+	payer        int
+	typeOfReturn string
+}
+
+// parseRecords reads all records of fire ascii of the file
+func parseRecords(f file.File) ([]parsedRecord, error) {
+	buf := f.Ascii()
+	if len(buf)%config.RecordLength != 0 {
+		return nil, utils.ErrInvalidAscii
+	}
+
+	var parsed []parsedRecord
+	payer, typeOfReturn := 0, ""
+	for offset := 0; offset < len(buf); offset += config.RecordLength {
+		data := buf[offset : offset+config.RecordLength]
+		var record records.Record
+		switch string(data[:1]) {
+		case config.TRecordType:
+			record = records.NewTRecord()
+		case config.ARecordType:
+			record = records.NewARecord()
+		case config.BRecordType:
+			record = records.NewBRecord(typeOfReturn)
+		case config.CRecordType:
+			record = records.NewCRecord()
+		case config.KRecordType:
+			record = records.NewKRecord()
+		case config.FRecordType:
+			record = records.NewFRecord()
+		default:
+			return nil, utils.ErrInvalidFile
+		}
+		if err := record.Parse(data); err != nil {
+			return nil, err
+		}
+
+		if a, ok := record.(*records.ARecord); ok {
+			payer, typeOfReturn = a.SequenceNumber(), config.TypeOfReturns[a.TypeOfReturn]
+		} else if record.Type() != config.TRecordType && record.Type() != config.FRecordType && payer == 0 {
+			return nil, utils.ErrInvalidFile
+		}
+		parsed = append(parsed, parsedRecord{record: record, payer: payer, typeOfReturn: typeOfReturn})
+	}
+	return parsed, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
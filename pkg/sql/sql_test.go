@@ -0,0 +1,126 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package sql
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/moov-io/identity/pkg/database"
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/file"
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/stretchr/testify/require"
+)
+
+func readFile(t *testing.T) file.File {
+	buf, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "oneTransactionFile.ascii"))
+	require.Nil(t, err)
+	f, err := file.CreateFile(buf)
+	require.Nil(t, err)
+	return f
+}
+
+func Test_Export_SQLite(t *testing.T) {
+	a := require.New(t)
+	f := readFile(t)
+
+	db, close, err := database.NewAndMigrate(database.InMemorySqliteConfig, nil, nil)
+	t.Cleanup(close)
+	a.Nil(err)
+	_, err = db.Exec("PRAGMA foreign_keys = ON")
+	a.Nil(err)
+
+	for _, fileID := range []string{"file1", "file2"} {
+		buf := &bytes.Buffer{}
+		a.Nil(Export(buf, SQLite, fileID, f))
+		_, err = db.Exec(buf.String())
+		a.Nil(err, buf.String())
+	}
+
+	var count int
+	a.Nil(db.QueryRow(`SELECT COUNT(*) FROM fire_payees WHERE file_id = 'file1'`).Scan(&count))
+	a.Equal(2, count)
+
+	var payerName, payeeTIN, stateCode string
+	var amount, stateTax int
+	err = db.QueryRow(`
+		SELECT a.first_payer_name, b.payees_tin, b.payment_amount_7, m.state_income_tax_withheld, m.combined_federal_state_code
+		FROM fire_payees b
+		JOIN fire_payers a ON a.file_id = b.file_id AND a.record_sequence_number = b.payer_sequence_number
+		JOIN fire_payees_1099_misc m ON m.file_id = b.file_id AND m.record_sequence_number = b.record_sequence_number
+		WHERE b.file_id = 'file2' AND b.record_sequence_number = 3
+	`).Scan(&payerName, &payeeTIN, &amount, &stateTax, &stateCode)
+	a.Nil(err)
+	a.Equal("ASDF GLOBAL INC", payerName)
+	a.Equal("987654321", payeeTIN)
+	a.Equal(700, amount)
+	a.Equal(4, stateTax)
+	a.Equal("1", stateCode)
+
+	a.Nil(db.QueryRow(`SELECT total_number_of_payees FROM fire_end_transmitters WHERE file_id = 'file1'`).Scan(&count))
+	a.Equal(2, count)
+
+	// records of a file can't be inserted twice
+	buf := &bytes.Buffer{}
+	a.Nil(InsertRecords(buf, SQLite, "file1", f))
+	_, err = db.Exec(buf.String())
+	a.NotNil(err)
+}
+
+func Test_Export_MySQL(t *testing.T) {
+	a := require.New(t)
+	f := readFile(t)
+
+	buf := &bytes.Buffer{}
+	a.Nil(Export(buf, MySQL, `it's\1`, f))
+	script := buf.String()
+	a.Contains(script, "CREATE TABLE IF NOT EXISTS `fire_payees_1099_misc` (\n  `file_id` VARCHAR(64) NOT NULL,\n  `record_sequence_number` BIGINT NOT NULL,")
+	a.Contains(script, "  FOREIGN KEY (`file_id`, `payer_sequence_number`) REFERENCES `fire_payers` (`file_id`, `record_sequence_number`)")
+	a.Contains(script, ") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;")
+	a.Contains(script, "START TRANSACTION;\nINSERT INTO `fire_transmitters` (`file_id`, `record_type`, `payment_year`")
+	a.Contains(script, `VALUES ('it''s\\1', 'T', 2017`)
+	a.True(strings.HasSuffix(script, "COMMIT;\n"))
+	a.Equal(7, strings.Count(script, "CREATE TABLE"))
+	// transmitter, payer, two payees with extension blocks, end of payer, state totals and end of transmission records
+	a.Equal(9, strings.Count(script, "INSERT INTO"))
+}
+
+func Test_CreateTables(t *testing.T) {
+	a := require.New(t)
+
+	buf := &bytes.Buffer{}
+	a.Nil(CreateTables(buf, SQLite, "1099-NEC", "W-2G", "1099-NEC"))
+	a.Contains(buf.String(), `CREATE TABLE IF NOT EXISTS "fire_payees_1099_nec"`)
+	a.Contains(buf.String(), `CREATE TABLE IF NOT EXISTS "fire_payees_w_2g"`)
+	a.Equal(8, strings.Count(buf.String(), "CREATE TABLE"))
+	a.NotContains(buf.String(), "blank")
+
+	a.NotNil(CreateTables(buf, SQLite, "1099-XYZ"))
+	err := CreateTables(buf, "postgres")
+	a.True(errors.Is(err, ErrUnsupportedDialect))
+}
+
+func Test_Columns(t *testing.T) {
+	a := require.New(t)
+
+	payee := records.NewBRecord(config.Sub1099MiscType).(*records.BRecord)
+	columns, err := Columns(payee)
+	a.Nil(err)
+	a.Equal(Column{Name: "record_type", Field: "RecordType"}, columns[0])
+	a.Contains(columns, Column{Name: "payment_amount_A", Field: "PaymentAmountA"})
+
+	columns, err = Columns(payee.SubRecord())
+	a.Nil(err)
+	a.Contains(columns, Column{Name: "combined_federal_state_code", Field: "CombinedFSCode"})
+	a.NotContains(columns, Column{Name: "record_type", Field: "RecordType"})
+
+	_, err = Columns(payee.PaymentAmounts())
+	a.NotNil(err)
+}
@@ -0,0 +1,554 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/moov-io/base"
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/file"
+	"github.com/moov-io/irs/pkg/records"
+	firesql "github.com/moov-io/irs/pkg/sql"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+// Repository - Used for interacting files on the data store
+//
+// Files are owned by the tenant that saved them, and files of other tenants are reported as sql.ErrNoRows.
+type Repository interface {
+	// ListFiles returns identifiers of all files stored by the tenant
+	ListFiles(tenantID string) ([]string, error)
+	// GetFile returns the stored file as it was saved
+	GetFile(tenantID, fileID string) (file.File, error)
+	// SaveFile stores the file, replacing all records of the file if it already exists
+	SaveFile(tenantID, fileID string, f file.File) error
+	// DeleteFile removes the file with all its records
+	DeleteFile(tenantID, fileID string) error
+	// AddPayees appends payee “B” records to the first payer “A” record of the file with the TIN,
+	// without updating totals of the file
+	AddPayees(tenantID, fileID, payerTIN string, payees []records.Record) error
+	// RenderFile returns the stored file with end of payer “C”, state totals “K” and end of transmission “F” records
+	// generated from its payee “B” records
+	RenderFile(tenantID, fileID string) (file.File, error)
+}
+
+// NewFileRepository - Builds a new repository tied to the DB passed in.
+func NewFileRepository(db *sql.DB) Repository {
+	return &sqlFileRepo{db: db}
+}
+
+// sqlFileRepo stores fields of records in columns of tables of the record types,
+// whose columns are columns of the record layouts as written by package sql
+type sqlFileRepo struct {
+	db *sql.DB
+}
+
+// storedPayer contains records of a payer to be stored
+type storedPayer struct {
+	record    *records.ARecord
+	endRecord records.Record
+	payees    []*records.BRecord
+	states    []records.Record
+}
+
+func (r *sqlFileRepo) ListFiles(tenantID string) ([]string, error) {
+	rows, err := r.db.Query(`
+		SELECT file_id
+		FROM files
+		WHERE tenant_id = ?
+		ORDER BY created_on, file_id
+	`, tenantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (r *sqlFileRepo) GetFile(tenantID, fileID string) (file.File, error) {
+	var buf bytes.Buffer
+
+	transmitter, endTransmitter := records.NewTRecord(), records.NewFRecord()
+	columns, err := selectColumns("transmitters", transmitter, "end_transmitters", endTransmitter)
+	if err != nil {
+		return nil, err
+	}
+	qry := `
+		SELECT ` + columns + `
+		FROM transmitters
+		INNER JOIN end_transmitters ON transmitters.file_id = end_transmitters.file_id
+		INNER JOIN files ON transmitters.file_id = files.file_id
+		WHERE transmitters.file_id = ? AND files.tenant_id = ?
+		LIMIT 1
+	`
+	dest, err := scanDest(transmitter, endTransmitter)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.db.QueryRow(qry, fileID, tenantID).Scan(dest...); err != nil {
+		return nil, err
+	}
+	buf.Write(transmitter.Ascii())
+
+	columns, err = selectColumns("payers", records.NewARecord(), "end_payers", records.NewCRecord())
+	if err != nil {
+		return nil, err
+	}
+	qry = `
+		SELECT payers.payer_id, ` + columns + `
+		FROM payers
+		INNER JOIN end_payers ON payers.payer_id = end_payers.payer_id
+		WHERE payers.file_id = ?
+		ORDER BY payers.position
+	`
+	payers, err := r.queryScanRecords(qry, func() []interface{} {
+		return []interface{}{new(string), records.NewARecord(), records.NewCRecord()}
+	}, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, payer := range payers {
+		payerID, record := *payer[0].(*string), payer[1].(*records.ARecord)
+		typeOfReturn := config.TypeOfReturns[record.TypeOfReturn]
+
+		payees, err := r.queryScanPayees(payerID, typeOfReturn)
+		if err != nil {
+			return nil, err
+		}
+
+		columns, err = selectColumns("states", records.NewKRecord())
+		if err != nil {
+			return nil, err
+		}
+		qry = `
+			SELECT ` + columns + `
+			FROM states
+			WHERE states.payer_id = ?
+			ORDER BY states.position
+		`
+		states, err := r.queryScanRecords(qry, func() []interface{} {
+			return []interface{}{records.NewKRecord()}
+		}, payerID)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(record.Ascii())
+		for _, payee := range payees {
+			buf.Write(payee.Ascii())
+		}
+		buf.Write(payer[2].(records.Record).Ascii())
+		for _, state := range states {
+			buf.Write(state[0].(records.Record).Ascii())
+		}
+	}
+	buf.Write(endTransmitter.Ascii())
+
+	return file.CreateFile(buf.Bytes())
+}
+
+func (r *sqlFileRepo) SaveFile(tenantID, fileID string, f file.File) error {
+	transmitter, endTransmitter, payers, err := splitRecords(f)
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now()
+	res, err := tx.Exec(`
+		UPDATE files
+		SET last_updated_on = ?
+		WHERE file_id = ? AND tenant_id = ?
+	`, now, fileID, tenantID)
+	if err != nil {
+		return err
+	}
+	if cnt, err := res.RowsAffected(); err != nil {
+		return err
+	} else if cnt == 0 {
+		qry := `
+			INSERT INTO files (file_id, tenant_id, created_on, last_updated_on)
+			VALUES (?, ?, ?, ?)
+		`
+		if _, err := tx.Exec(qry, fileID, tenantID, now, now); err != nil {
+			return err
+		}
+	} else if err := deleteRecords(tx, fileID); err != nil {
+		return err
+	}
+
+	if err := insertRecord(tx, "transmitters", transmitter, []string{"file_id"}, fileID); err != nil {
+		return err
+	}
+	if err := insertRecord(tx, "end_transmitters", endTransmitter, []string{"file_id"}, fileID); err != nil {
+		return err
+	}
+
+	for position, payer := range payers {
+		payerID := base.ID()
+		if err := insertRecord(tx, "payers", payer.record, []string{"payer_id", "file_id", "position"}, payerID, fileID, position); err != nil {
+			return err
+		}
+		if err := insertRecord(tx, "end_payers", payer.endRecord, []string{"payer_id"}, payerID); err != nil {
+			return err
+		}
+
+		for position, payee := range payer.payees {
+			if err := insertPayee(tx, payerID, position, payee); err != nil {
+				return err
+			}
+		}
+
+		for position, state := range payer.states {
+			if err := insertRecord(tx, "states", state, []string{"payer_id", "position"}, payerID, position); err != nil {
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *sqlFileRepo) DeleteFile(tenantID, fileID string) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// records of files of other tenants are kept
+	var id string
+	if err := tx.QueryRow(`
+		SELECT file_id
+		FROM files
+		WHERE file_id = ? AND tenant_id = ?
+	`, fileID, tenantID).Scan(&id); err != nil {
+		return err
+	}
+
+	if err := deleteRecords(tx, fileID); err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(`
+		DELETE FROM files
+		WHERE file_id = ?
+	`, fileID); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *sqlFileRepo) AddPayees(tenantID, fileID, payerTIN string, payees []records.Record) error {
+	tx, err := r.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	qry := `
+		SELECT payers.payer_id, payers.type_of_return, COUNT(payees.payee_id)
+		FROM payers
+		INNER JOIN files ON payers.file_id = files.file_id
+		LEFT JOIN payees ON payers.payer_id = payees.payer_id
+		WHERE payers.file_id = ? AND files.tenant_id = ? AND payers.payer_tin = ?
+		GROUP BY payers.payer_id, payers.type_of_return, payers.position
+		ORDER BY payers.position
+		LIMIT 1
+	`
+	var payerID, typeOfReturn string
+	var position int
+	if err := tx.QueryRow(qry, fileID, tenantID, payerTIN).Scan(&payerID, &typeOfReturn, &position); err != nil {
+		return err
+	}
+
+	for _, record := range payees {
+		payee, ok := record.(*records.BRecord)
+		if !ok {
+			return fmt.Errorf("unexpected payee to be a BRecord, but got %T", record)
+		}
+		if payee.TypeOfReturn() != config.TypeOfReturns[typeOfReturn] {
+			return utils.NewErrMismatchedField("type of return", config.ARecordType, config.TypeOfReturns[typeOfReturn])
+		}
+		if err := insertPayee(tx, payerID, position, payee); err != nil {
+			return err
+		}
+		position++
+	}
+
+	_, err = tx.Exec(`
+		UPDATE files
+		SET last_updated_on = ?
+		WHERE file_id = ?
+	`, time.Now(), fileID)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *sqlFileRepo) RenderFile(tenantID, fileID string) (file.File, error) {
+	f, err := r.GetFile(tenantID, fileID)
+	if err != nil {
+		return nil, err
+	}
+	if err := f.GenerateEndPayers(); err != nil {
+		return nil, err
+	}
+	if err := f.GenerateStates(); err != nil {
+		return nil, err
+	}
+	if err := f.GenerateEndTransmitter(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// queryScanPayees returns payee “B” records of the payer with their extension blocks
+func (r *sqlFileRepo) queryScanPayees(payerID, typeOfReturn string) ([]records.Record, error) {
+	payee := records.NewBRecord(typeOfReturn).(*records.BRecord)
+	var qry string
+	if payee.SubRecord() == nil {
+		columns, err := selectColumns("payees", payee)
+		if err != nil {
+			return nil, err
+		}
+		qry = `
+			SELECT ` + columns + `
+			FROM payees
+			WHERE payees.payer_id = ?
+			ORDER BY payees.position
+		`
+	} else {
+		extension := extensionTable(typeOfReturn)
+		columns, err := selectColumns("payees", payee, extension, payee.SubRecord())
+		if err != nil {
+			return nil, err
+		}
+		qry = `
+			SELECT ` + columns + `
+			FROM payees
+			INNER JOIN ` + extension + ` ON payees.payee_id = ` + extension + `.payee_id
+			WHERE payees.payer_id = ?
+			ORDER BY payees.position
+		`
+	}
+
+	rows, err := r.queryScanRecords(qry, func() []interface{} {
+		payee := records.NewBRecord(typeOfReturn).(*records.BRecord)
+		if payee.SubRecord() == nil {
+			return []interface{}{payee}
+		}
+		return []interface{}{payee, payee.SubRecord()}
+	}, payerID)
+	if err != nil {
+		return nil, err
+	}
+
+	payees := make([]records.Record, 0, len(rows))
+	for _, row := range rows {
+		payees = append(payees, row[0].(records.Record))
+	}
+	return payees, nil
+}
+
+// queryScanRecords returns values of rows created by newRow, scanning keys into string pointers
+// and columns of records into fields of the records
+func (r *sqlFileRepo) queryScanRecords(query string, newRow func() []interface{}, args ...interface{}) ([][]interface{}, error) {
+	rows, err := r.db.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := [][]interface{}{}
+	for rows.Next() {
+		row := newRow()
+		dest, err := scanDest(row...)
+		if err != nil {
+			return nil, err
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		list = append(list, row)
+	}
+	return list, rows.Err()
+}
+
+// scanDest returns destinations of scanning values, string pointers are scanned as they are
+// and records are scanned into their fields in order of their columns
+func scanDest(values ...interface{}) ([]interface{}, error) {
+	var dest []interface{}
+	for _, value := range values {
+		if key, ok := value.(*string); ok {
+			dest = append(dest, key)
+			continue
+		}
+
+		columns, err := firesql.Columns(value)
+		if err != nil {
+			return nil, err
+		}
+		fields := reflect.Indirect(reflect.ValueOf(value))
+		for _, c := range columns {
+			dest = append(dest, fields.FieldByName(c.Field).Addr().Interface())
+		}
+	}
+	return dest, nil
+}
+
+// selectColumns returns columns of fields of records qualified by their tables,
+// arguments are pairs of a table and its record
+func selectColumns(tablesAndRecords ...interface{}) (string, error) {
+	var names []string
+	for i := 0; i+1 < len(tablesAndRecords); i += 2 {
+		columns, err := firesql.Columns(tablesAndRecords[i+1])
+		if err != nil {
+			return "", err
+		}
+		for _, c := range columns {
+			names = append(names, fmt.Sprintf("%s.%s", tablesAndRecords[i], quote(c.Name)))
+		}
+	}
+	return strings.Join(names, ", "), nil
+}
+
+// quote returns the quoted column, since fields such as “transaction” of W-2G are reserved words.
+// Backticks quote identifiers in both SQLite and MySQL.
+func quote(column string) string {
+	return "`" + column + "`"
+}
+
+// insertRecord inserts fields of the record into columns of the table, after values of key columns
+func insertRecord(tx *sql.Tx, table string, record interface{}, keyColumns []string, keys ...interface{}) error {
+	columns, err := firesql.Columns(record)
+	if err != nil {
+		return err
+	}
+
+	names := append([]string{}, keyColumns...)
+	values := append([]interface{}{}, keys...)
+	fields := reflect.Indirect(reflect.ValueOf(record))
+	for _, c := range columns {
+		names = append(names, quote(c.Name))
+		values = append(values, fields.FieldByName(c.Field).Interface())
+	}
+
+	qry := fmt.Sprintf(`
+		INSERT INTO %s (%s)
+		VALUES (%s)
+	`, table, strings.Join(names, ", "), strings.TrimSuffix(strings.Repeat("?, ", len(names)), ", "))
+	_, err = tx.Exec(qry, values...)
+	return err
+}
+
+// insertPayee inserts the payee “B” record and its extension block
+func insertPayee(tx *sql.Tx, payerID string, position int, payee *records.BRecord) error {
+	payeeID := base.ID()
+	if err := insertRecord(tx, "payees", payee, []string{"payee_id", "payer_id", "position"}, payeeID, payerID, position); err != nil {
+		return err
+	}
+	if payee.SubRecord() == nil {
+		return nil
+	}
+	return insertRecord(tx, extensionTable(payee.TypeOfReturn()), payee.SubRecord(), []string{"payee_id"}, payeeID)
+}
+
+// extensionTable returns name of the table of extension blocks of payee “B” records of the type of return,
+// such as payees_1099_misc for 1099-MISC
+func extensionTable(typeOfReturn string) string {
+	return "payees_" + strings.ToLower(strings.ReplaceAll(typeOfReturn, "-", "_"))
+}
+
+// deleteRecords removes all records of the file, keeping the file itself
+func deleteRecords(tx *sql.Tx, fileID string) error {
+	var queries []string
+	for typeOfReturn := range config.SubRecordLayouts {
+		queries = append(queries, `DELETE FROM `+extensionTable(typeOfReturn)+` WHERE payee_id IN (
+			SELECT payees.payee_id FROM payees
+			INNER JOIN payers ON payees.payer_id = payers.payer_id
+			WHERE payers.file_id = ?)`)
+	}
+	queries = append(queries,
+		`DELETE FROM payees WHERE payer_id IN (SELECT payer_id FROM payers WHERE file_id = ?)`,
+		`DELETE FROM states WHERE payer_id IN (SELECT payer_id FROM payers WHERE file_id = ?)`,
+		`DELETE FROM end_payers WHERE payer_id IN (SELECT payer_id FROM payers WHERE file_id = ?)`,
+		`DELETE FROM payers WHERE file_id = ?`,
+		`DELETE FROM end_transmitters WHERE file_id = ?`,
+		`DELETE FROM transmitters WHERE file_id = ?`,
+	)
+	for _, qry := range queries {
+		if _, err := tx.Exec(qry, fileID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitRecords reads records of the file into records of the transmitter and payers.
+// Problems of records are kept, so that the file is stored as it was given.
+func splitRecords(f file.File) (records.Record, records.Record, []*storedPayer, error) {
+	reader := file.NewReader(bytes.NewReader(f.Ascii()))
+
+	var transmitter, endTransmitter records.Record
+	var payers []*storedPayer
+	var payer *storedPayer
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if _, ok := err.(*utils.ValidationReport); err != nil && !ok {
+			return nil, nil, nil, err
+		}
+
+		switch rec := record.(type) {
+		case *records.TRecord:
+			transmitter = rec
+		case *records.ARecord:
+			payer = &storedPayer{record: rec}
+			payers = append(payers, payer)
+		case *records.BRecord:
+			payer.payees = append(payer.payees, rec)
+		case *records.CRecord:
+			payer.endRecord = rec
+		case *records.KRecord:
+			payer.states = append(payer.states, rec)
+		case *records.FRecord:
+			endTransmitter = rec
+		}
+	}
+
+	if transmitter == nil || endTransmitter == nil {
+		return nil, nil, nil, utils.ErrInvalidFile
+	}
+	return transmitter, endTransmitter, payers, nil
+}
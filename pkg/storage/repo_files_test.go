@@ -0,0 +1,150 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package storage
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/moov-io/identity/pkg/database"
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/file"
+	"github.com/moov-io/irs/pkg/records"
+	"github.com/stretchr/testify/require"
+)
+
+const tenantID = "c5d5ee1c-2f0a-4cd3-9bd1-2e1bd3a5e2f1"
+
+func setupRepository(t *testing.T) (*require.Assertions, Repository, file.File) {
+	a := require.New(t)
+
+	db, close, err := database.NewAndMigrate(database.InMemorySqliteConfig, nil, nil)
+	t.Cleanup(close)
+	a.Nil(err)
+
+	buf, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "oneTransactionFile.ascii"))
+	a.Nil(err)
+	f, err := file.CreateFile(buf)
+	a.Nil(err)
+
+	return a, NewFileRepository(db), f
+}
+
+func Test_SaveAndGetFile(t *testing.T) {
+	a, repo, f := setupRepository(t)
+
+	a.Nil(repo.SaveFile(tenantID, "file1", f))
+	stored, err := repo.GetFile(tenantID, "file1")
+	a.Nil(err)
+	a.Equal(string(f.Ascii()), string(stored.Ascii()))
+
+	// saving again replaces records of the file
+	a.Nil(repo.SaveFile(tenantID, "file1", stored))
+	ids, err := repo.ListFiles(tenantID)
+	a.Nil(err)
+	a.Equal([]string{"file1"}, ids)
+	stored, err = repo.GetFile(tenantID, "file1")
+	a.Nil(err)
+	a.Equal(string(f.Ascii()), string(stored.Ascii()))
+}
+
+func Test_GetFile_NotFound(t *testing.T) {
+	a, repo, _ := setupRepository(t)
+
+	_, err := repo.GetFile(tenantID, "unknown")
+	a.Equal(sql.ErrNoRows, err)
+}
+
+func Test_DeleteFile(t *testing.T) {
+	a, repo, f := setupRepository(t)
+
+	a.Nil(repo.SaveFile(tenantID, "file1", f))
+	a.Nil(repo.SaveFile(tenantID, "file2", f))
+	a.Nil(repo.DeleteFile(tenantID, "file1"))
+
+	ids, err := repo.ListFiles(tenantID)
+	a.Nil(err)
+	a.Equal([]string{"file2"}, ids)
+	_, err = repo.GetFile(tenantID, "file1")
+	a.Equal(sql.ErrNoRows, err)
+	a.Equal(sql.ErrNoRows, repo.DeleteFile(tenantID, "file1"))
+}
+
+func Test_FilesOfOtherTenants(t *testing.T) {
+	a, repo, f := setupRepository(t)
+	other := "0b0d4c55-8d2f-4a4e-9a57-5a8d2b1f9c3e"
+
+	a.Nil(repo.SaveFile(tenantID, "file1", f))
+	ids, err := repo.ListFiles(other)
+	a.Nil(err)
+	a.Empty(ids)
+	_, err = repo.GetFile(other, "file1")
+	a.Equal(sql.ErrNoRows, err)
+	_, err = repo.RenderFile(other, "file1")
+	a.Equal(sql.ErrNoRows, err)
+	a.Equal(sql.ErrNoRows, repo.DeleteFile(other, "file1"))
+	a.Equal(sql.ErrNoRows, repo.AddPayees(other, "file1", "123456789", nil))
+
+	stored, err := repo.GetFile(tenantID, "file1")
+	a.Nil(err)
+	a.Equal(string(f.Ascii()), string(stored.Ascii()))
+}
+
+func Test_AddPayeesAndRenderFile(t *testing.T) {
+	a, repo, f := setupRepository(t)
+	a.Nil(repo.SaveFile(tenantID, "file1", f))
+
+	buf, err := ioutil.ReadFile(filepath.Join("..", "..", "test", "testdata", "payeeRecordWith1099Misc.ascii"))
+	a.Nil(err)
+	payee := records.NewBRecord(config.Sub1099MiscType).(*records.BRecord)
+	a.Nil(payee.Parse(buf))
+	// amount codes that aren't reported by the payer
+	payee.PaymentAmount9, payee.PaymentAmountF, payee.PaymentAmountG = 0, 0, 0
+	a.Nil(repo.AddPayees(tenantID, "file1", "123456789", []records.Record{payee}))
+
+	stored, err := repo.GetFile(tenantID, "file1")
+	a.Nil(err)
+	a.NotNil(stored.Validate())
+
+	rendered, err := repo.RenderFile(tenantID, "file1")
+	a.Nil(err)
+	a.Nil(rendered.Validate())
+	a.Len(rendered.Ascii(), len(f.Ascii())+config.RecordLength)
+
+	nec := records.NewBRecord(config.Sub1099NecType)
+	a.NotNil(repo.AddPayees(tenantID, "file1", "123456789", []records.Record{nec}))
+	a.Equal(sql.ErrNoRows, repo.AddPayees(tenantID, "file1", "999999999", []records.Record{payee}))
+}
+
+func Test_SaveFile_Columns(t *testing.T) {
+	a, _, f := setupRepository(t)
+	db, close, err := database.NewAndMigrate(database.InMemorySqliteConfig, nil, nil)
+	t.Cleanup(close)
+	a.Nil(err)
+	a.Nil(NewFileRepository(db).SaveFile(tenantID, "file1", f))
+
+	var payeeTIN string
+	var amount, stateTax, stateCode int
+	err = db.QueryRow(`
+		SELECT payees.payees_tin, payees.payment_amount_7, m.state_income_tax_withheld, m.combined_federal_state_code
+		FROM payees
+		INNER JOIN payers ON payers.payer_id = payees.payer_id
+		INNER JOIN payees_1099_misc m ON m.payee_id = payees.payee_id
+		WHERE payers.file_id = 'file1' AND payers.payer_tin = '123456789'
+		ORDER BY payees.position
+		LIMIT 1
+	`).Scan(&payeeTIN, &amount, &stateTax, &stateCode)
+	a.Nil(err)
+	a.Equal("987654321", payeeTIN)
+	a.Equal(700, amount)
+	a.Equal(4, stateTax)
+	a.Equal(1, stateCode)
+
+	var totalPayees int
+	a.Nil(db.QueryRow(`SELECT total_number_of_payees FROM end_transmitters WHERE file_id = 'file1'`).Scan(&totalPayees))
+	a.Equal(2, totalPayees)
+}
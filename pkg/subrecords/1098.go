@@ -0,0 +1,115 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub1098 struct {
+	// Required. Enter the date of the mortgage origination. Format
+	// the date as YYYYMMDD (for example, January 5, 2019, would
+	// be 20190105). Do not enter hyphens or slashes.
+	MortgageOriginationDate string `json:"mortgage_origination_date"`
+
+	// Enter “1” (one) if the address of the property securing the
+	// mortgage is the same as the payer/borrower’s address.
+	// Otherwise, enter a blank.
+	PropertySecuringMortgageIndicator string `json:"property_securing_mortgage_indicator"`
+
+	// If the Property Securing Mortgage Indicator is blank, enter the
+	// address of the property securing the mortgage. Left justify the
+	// information and fill unused positions with blanks.
+	PropertyAddress string `json:"property_address_securing_mortgage"`
+
+	// If the address of the property securing the mortgage is not
+	// available, enter the description of the property, including the
+	// Assessor Parcel Number. Left justify the information and fill
+	// unused positions with blanks.
+	Other string `json:"other"`
+
+	// Enter the number of properties securing the mortgage. If
+	// there is only one property, enter blanks. Right justify the
+	// information and fill unused positions with zeros.
+	NumberMortgagedProperties string `json:"number_of_mortgaged_properties"`
+
+	// This portion of the “B” Record may be used to record
+	// information for state or local government reporting or for the
+	// filer’s own purposes. Payers should contact the state or local
+	// revenue departments for filing requirements. If this field is not
+	// used, enter blanks.
+	SpecialDataEntries string `json:"special_data_entries"`
+
+	// Enter the date the mortgage was acquired, if the mortgage was
+	// acquired in the current year. Format the date as YYYYMMDD
+	// (for example, January 5, 2019, would be 20190105). Do not
+	// enter hyphens or slashes. Otherwise, enter blanks.
+	MortgageAcquisitionDate string `json:"mortgage_acquisition_date"`
+}
+
+// Type returns type of “1098” record
+func (r *Sub1098) Type() string {
+	return config.Sub1098Type
+}
+
+// Parse parses the “1098” record from fire ascii
+func (r *Sub1098) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub1098Layout, record)
+}
+
+// Ascii returns fire ascii of “1098” record
+func (r *Sub1098) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub1098Layout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub1098) Validate() error {
+	return utils.Validate(r, config.Sub1098Layout)
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub1098) ValidatePropertySecuringMortgageIndicator() error {
+	if r.PropertySecuringMortgageIndicator == config.PropertySecuringMortgageIndicator || len(r.PropertySecuringMortgageIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("property securing mortgage indicator")
+}
+
+func (r *Sub1098) ValidateMortgageAcquisitionDate() error {
+	if len(r.MortgageAcquisitionDate) > 0 && r.MortgageAcquisitionDate < r.MortgageOriginationDate {
+		return utils.NewErrValidValue("mortgage acquisition date (before mortgage origination date)")
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub1098C struct {
+	// Enter “1” (one) if the vehicle was sold in an arm’s length
+	// transaction to an unrelated party. Otherwise, enter a blank.
+	TransactionIndicator string `json:"transaction_indicator"`
+
+	// Enter “1” (one) if the vehicle will not be transferred for money,
+	// other property, or services before completion of material
+	// improvements or significant intervening use. Otherwise, enter
+	// a blank.
+	TransferAfterImprovementsIndicator string `json:"transfer_after_improvements_indicator"`
+
+	// Enter “1” (one) if the vehicle is transferred to a needy individual
+	// for significantly below fair market value. Otherwise, enter a
+	// blank.
+	TransferBelowFMVIndicator string `json:"transfer_below_fair_market_value_indicator"`
+
+	// Enter “1” (one) if the goods and services provided consisted
+	// solely of intangible religious benefits. Otherwise, enter a blank.
+	IntangibleReligiousBenefitsIndicator string `json:"intangible_religious_benefits_indicator"`
+
+	// Enter “1” (one) if under the law the donor cannot claim a
+	// deduction of more than $500 for the vehicle. Otherwise, enter
+	// a blank.
+	DeductionLessThan500Indicator string `json:"deduction_less_than_or_equal_to_500_indicator"`
+
+	// Enter the odometer mileage of the donated vehicle. Right
+	// justify the information and fill unused positions with zeros.
+	OdometerMileage int `json:"form_1098_c_odometer_mileage"`
+
+	// Enter the year of the donated vehicle in YYYY format.
+	Year string `json:"year"`
+
+	// Enter the make of the donated vehicle. Left justify the
+	// information and fill unused positions with blanks.
+	Make string `json:"make"`
+
+	// Enter the model of the donated vehicle. Left justify the
+	// information and fill unused positions with blanks.
+	Model string `json:"model"`
+
+	// Enter the vehicle or other identification number of the donated
+	// vehicle. Left justify the information and fill unused positions
+	// with blanks.
+	VehicleIdentificationNumber string `json:"vehicle_or_other_identification_number"`
+
+	// Enter a description of material improvements or significant
+	// intervening use and duration of use. Left justify the
+	// information and fill unused positions with blanks.
+	VehicleDescription string `json:"vehicle_description"`
+
+	// Enter the date the contribution was made to an organization.
+	// Format the date as YYYYMMDD (for example, January 5, 2019,
+	// would be 20190105). Do not enter hyphens or slashes.
+	DateOfContribution string `json:"date_of_contribution"`
+
+	// Enter the date the vehicle was sold by the organization.
+	// Format the date as YYYYMMDD (for example, January 5, 2019,
+	// would be 20190105). Do not enter hyphens or slashes.
+	// Otherwise, enter blanks.
+	DateOfSale string `json:"date_of_sale"`
+
+	// Enter a description of the goods and services received for
+	// the donated vehicle, if any. Left justify the information and fill
+	// unused positions with blanks.
+	GoodsAndServices string `json:"goods_and_services"`
+}
+
+// Type returns type of “1098-C” record
+func (r *Sub1098C) Type() string {
+	return config.Sub1098CType
+}
+
+// Parse parses the “1098-C” record from fire ascii
+func (r *Sub1098C) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub1098CLayout, record)
+}
+
+// Ascii returns fire ascii of “1098-C” record
+func (r *Sub1098C) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub1098CLayout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub1098C) Validate() error {
+	return utils.Validate(r, config.Sub1098CLayout)
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub1098C) ValidateTransactionIndicator() error {
+	if r.TransactionIndicator == config.VehicleDonationIndicator || len(r.TransactionIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("transaction indicator")
+}
+
+func (r *Sub1098C) ValidateTransferAfterImprovementsIndicator() error {
+	if r.TransferAfterImprovementsIndicator == config.VehicleDonationIndicator || len(r.TransferAfterImprovementsIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("transfer after improvements indicator")
+}
+
+func (r *Sub1098C) ValidateTransferBelowFMVIndicator() error {
+	if r.TransferBelowFMVIndicator == config.VehicleDonationIndicator || len(r.TransferBelowFMVIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("transfer below fair market value indicator")
+}
+
+func (r *Sub1098C) ValidateIntangibleReligiousBenefitsIndicator() error {
+	if r.IntangibleReligiousBenefitsIndicator == config.VehicleDonationIndicator || len(r.IntangibleReligiousBenefitsIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("intangible religious benefits indicator")
+}
+
+func (r *Sub1098C) ValidateDeductionLessThan500Indicator() error {
+	if r.DeductionLessThan500Indicator == config.VehicleDonationIndicator || len(r.DeductionLessThan500Indicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("deduction less than or equal to $500 indicator")
+}
+
+func (r *Sub1098C) ValidateDateOfSale() error {
+	if len(r.DateOfSale) > 0 && r.DateOfSale < r.DateOfContribution {
+		return utils.NewErrValidValue("date of sale (before date of contribution)")
+	}
+	return nil
+}
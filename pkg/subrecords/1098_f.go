@@ -0,0 +1,106 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub1098F struct {
+	// Enter the date of the order or agreement. Format the date as
+	// YYYYMMDD (for example, January 5, 2019, would be 20190105).
+	// Do not enter hyphens or slashes.
+	DateOfOrder string `json:"date_of_order_agreement"`
+
+	// Enter the jurisdiction for the fines, penalties, or other
+	// amounts being assessed. Left justify the information and fill
+	// unused positions with blanks.
+	Jurisdiction string `json:"jurisdiction"`
+
+	// Enter the case number assigned to the order or agreement, if
+	// applicable. Left justify the information and fill unused
+	// positions with blanks.
+	CaseNumber string `json:"case_number"`
+
+	// Enter the name or names of the case or the names of the
+	// parties to the suit, order, or agreement. Left justify the
+	// information and fill unused positions with blanks.
+	CaseName string `json:"case_name"`
+
+	// Enter all applicable codes from the table below. Enter each code
+	// only once. Left justify the information and fill unused positions
+	// with blanks.
+	// A: Multiple payers/defendants
+	// B: Multiple payees
+	// C: Property included in settlement
+	// D: Settlement paid in full as of time of filing
+	// E: No payment received as of time of filing
+	// F: Deferred prosecution agreement
+	// G: Non-prosecution agreement
+	// H: Other
+	PaymentCode string `json:"payment_code"`
+}
+
+// Type returns type of “1098-F” record
+func (r *Sub1098F) Type() string {
+	return config.Sub1098FType
+}
+
+// Parse parses the “1098-F” record from fire ascii
+func (r *Sub1098F) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub1098FLayout, record)
+}
+
+// Ascii returns fire ascii of “1098-F” record
+func (r *Sub1098F) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub1098FLayout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub1098F) Validate() error {
+	return utils.Validate(r, config.Sub1098FLayout)
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub1098F) ValidatePaymentCode() error {
+	codes := map[rune]bool{}
+	for _, code := range r.PaymentCode {
+		if _, ok := config.FinesPaymentCodes[string(code)]; !ok || codes[code] {
+			return utils.NewErrValidValue("payment code")
+		}
+		codes[code] = true
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub1098Q struct {
+	// Enter the date payments will start. Format the date as
+	// YYYYMMDD (for example, January 5, 2019, would be 20190105).
+	// Do not enter hyphens or slashes.
+	AnnuityStartDate string `json:"annuity_start_date"`
+
+	// Enter “1” (one) if the start date may be accelerated.
+	// Otherwise, enter a blank.
+	StartDateMayBeAccelerated string `json:"start_date_may_be_accelerated"`
+
+	// Enter the date of birth of the participant. Format the date as
+	// YYYYMMDD (for example, January 5, 2019, would be 20190105).
+	// Do not enter hyphens or slashes.
+	DateOfBirth string `json:"date_of_birth"`
+
+	// Enter the name of the plan. Left justify the information and fill
+	// unused positions with blanks.
+	PlanName string `json:"plan_name"`
+
+	// Enter the three digit plan number assigned by the employer.
+	PlanNumber string `json:"plan_number"`
+
+	// Enter the nine digit employer identification number of the
+	// plan sponsor.
+	PlanSponsorEIN string `json:"plan_sponsor_ein"`
+
+	// This portion of the “B” Record may be used to record
+	// information for state or local government reporting or for the
+	// filer’s own purposes. Payers should contact the state or local
+	// revenue departments for filing requirements. If this field is not
+	// used, enter blanks.
+	SpecialDataEntries string `json:"special_data_entries"`
+}
+
+// Type returns type of “1098-Q” record
+func (r *Sub1098Q) Type() string {
+	return config.Sub1098QType
+}
+
+// Parse parses the “1098-Q” record from fire ascii
+func (r *Sub1098Q) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub1098QLayout, record)
+}
+
+// Ascii returns fire ascii of “1098-Q” record
+func (r *Sub1098Q) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub1098QLayout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub1098Q) Validate() error {
+	return utils.Validate(r, config.Sub1098QLayout)
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub1098Q) ValidateStartDateMayBeAccelerated() error {
+	if r.StartDateMayBeAccelerated == config.StartDateMayBeAcceleratedIndicator || len(r.StartDateMayBeAccelerated) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("start date may be accelerated indicator")
+}
+
+func (r *Sub1098Q) ValidateAnnuityStartDate() error {
+	if len(r.DateOfBirth) > 0 && r.AnnuityStartDate < r.DateOfBirth {
+		return utils.NewErrValidValue("annuity start date (before date of birth)")
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub1098T struct {
+	// Enter “1” (one) if the student was at least a half-time student
+	// during any academic period that began in the calendar year.
+	// Otherwise, enter a blank.
+	HalfTimeStudentIndicator string `json:"half_time_student_indicator"`
+
+	// Enter “1” (one) if the student is enrolled exclusively in a
+	// graduate level program. Otherwise, enter a blank.
+	GraduateStudentIndicator string `json:"graduate_student_indicator"`
+
+	// Enter “1” (one) if the amount in Amount Code 1 includes
+	// amounts for an academic period beginning January through
+	// March of the following year. Otherwise, enter a blank.
+	AcademicPeriodIndicator string `json:"academic_period_indicator"`
+
+	// This portion of the “B” Record may be used to record
+	// information for state or local government reporting or for the
+	// filer’s own purposes. Payers should contact the state or local
+	// revenue departments for filing requirements. If this field is not
+	// used, enter blanks.
+	SpecialDataEntries string `json:"special_data_entries"`
+}
+
+// Type returns type of “1098-T” record
+func (r *Sub1098T) Type() string {
+	return config.Sub1098TType
+}
+
+// Parse parses the “1098-T” record from fire ascii
+func (r *Sub1098T) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub1098TLayout, record)
+}
+
+// Ascii returns fire ascii of “1098-T” record
+func (r *Sub1098T) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub1098TLayout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub1098T) Validate() error {
+	return utils.Validate(r, config.Sub1098TLayout)
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub1098T) ValidateHalfTimeStudentIndicator() error {
+	if r.HalfTimeStudentIndicator == config.HalfTimeStudentIndicator || len(r.HalfTimeStudentIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("half-time student indicator")
+}
+
+func (r *Sub1098T) ValidateGraduateStudentIndicator() error {
+	if r.GraduateStudentIndicator == config.GraduateStudentIndicator || len(r.GraduateStudentIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("graduate student indicator")
+}
+
+func (r *Sub1098T) ValidateAcademicPeriodIndicator() error {
+	if r.AcademicPeriodIndicator == config.AcademicPeriodIndicator || len(r.AcademicPeriodIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("academic period indicator")
+}
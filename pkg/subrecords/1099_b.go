@@ -0,0 +1,242 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub1099B struct {
+	// Enter “2” (two) to indicate notification by the IRS twice within
+	// three calendar years that the payee provided an incorrect
+	// name and/or TIN combination. Otherwise, enter a blank.
+	SecondTinNotice string `json:"second_tin_notice"`
+
+	// Enter “2” (two) to indicate noncovered securities. Otherwise,
+	// enter a blank.
+	NoncoveredSecurityIndicator string `json:"noncovered_security_indicator"`
+
+	// Required, if applicable. Enter the appropriate indicator from
+	// the following table. Otherwise, enter a blank.
+	// 1: Short-term gain or loss
+	// 2: Long-term gain or loss
+	// 3: Ordinary
+	TypeOfGainLossIndicator string `json:"type_of_gain_or_loss_indicator"`
+
+	// Required, if applicable. Enter the appropriate indicator from
+	// the following table. Otherwise, enter a blank.
+	// 1: Gross proceeds
+	// 2: Gross proceeds less commissions and option premiums
+	GrossProceedsIndicator string `json:"gross_proceeds_indicator"`
+
+	// Enter the trade date of the sale or exchange. Enter blanks for
+	// aggregate reporting or when reporting Form 1099-B
+	// transactions for regulated futures contracts, foreign currency
+	// contracts and bartering. Format the date as YYYYMMDD
+	// (for example, January 5, 2019, would be 20190105). Do not
+	// enter hyphens or slashes.
+	DateSold string `json:"date_sold_or_disposed"`
+
+	// Enter the CUSIP (Committee on Uniform Security
+	// Identification Procedures) number of the item reported for
+	// Amount Code 2 (Stocks, bonds, etc.). Enter blanks if this is
+	// an aggregate transaction. Enter “0s” (zeros) if the number is
+	// not available. Right justify the information and fill unused
+	// positions with blanks.
+	CUSIP string `json:"cusip_number"`
+
+	// Enter a brief description of the disposition item (for example,
+	// 100 shares of XYZ Corp). For regulated futures and forward
+	// contracts, enter “RFC” or other appropriate description, and
+	// any amount subject to backup withholding. For bartering, enter
+	// the service or property provided. Left justify the information
+	// and fill unused positions with blanks.
+	Description string `json:"description_of_property"`
+
+	// Enter the date of acquisition. Format the date as YYYYMMDD
+	// (for example, January 5, 2019, would be 20190105). Do not
+	// enter hyphens or slashes. Enter blanks if this is an aggregate
+	// transaction.
+	DateAcquired string `json:"date_acquired"`
+
+	// Enter “1” (one) if the recipient is unable to claim a loss on their
+	// tax return based on dollar amount in Amount Code 2. Otherwise,
+	// enter a blank.
+	LossNotAllowedIndicator string `json:"loss_not_allowed_indicator"`
+
+	// Enter the appropriate indicator of the check box on Form 8949
+	// that reflects whether the basis was reported to the IRS.
+	// Otherwise, enter a blank.
+	// A: Short-term transaction for which basis was reported to the IRS
+	// B: Short-term transaction for which basis was not reported to the IRS
+	// D: Long-term transaction for which basis was reported to the IRS
+	// E: Long-term transaction for which basis was not reported to the IRS
+	// X: If unable to determine whether short-term or long-term
+	ReportedToIRS string `json:"applicable_check_box_of_form_8949"`
+
+	// Enter “1” (one) to report the sale of a collectible.
+	// Otherwise, enter a blank.
+	CollectiblesIndicator string `json:"applicable_check_box_for_collectibles"`
+
+	// Enter "1" (one) if there is FATCA filing requirement.
+	// Otherwise, enter a blank.
+	FATCA string `json:"fatca_requirement_indicator"`
+
+	// Enter “1” (one) to report proceeds from a Qualified
+	// Opportunity Fund (QOF). Otherwise, enter a blank.
+	QOFIndicator string `json:"applicable_check_box_for_qof"`
+
+	// This portion of the “B” Record may be used to record
+	// information for state or local government reporting or for the
+	// filer’s own purposes. Payers should contact the state or local
+	// revenue departments for filing requirements. If this field is not
+	// used, enter blanks.
+	SpecialDataEntries string `json:"special_data_entries"`
+
+	// State income tax withheld is for the convenience of the filers.
+	// This information does not need to be reported to the IRS. If
+	// not reporting state tax withheld, this field may be used as a
+	// continuation of the Special Data Entries Field. The payment
+	// amount must be right justified and unused positions
+	// zero-filled.
+	StateIncomeTaxWithheld int `json:"state_income_tax_withheld"`
+
+	// Local income tax withheld is for the convenience of the filers.
+	// This information does not need to be reported to the IRS. If
+	// not reporting local tax withheld, this field may be used as a
+	// continuation of the Special Data Entries Field. The payment
+	// amount must be right justified and unused positions
+	// zero-filled.
+	LocalIncomeTaxWithheld int `json:"local_income_tax_withheld"`
+
+	// Enter the valid CF/SF code if this payee record is to be
+	// forwarded to a state agency as part of the CF/SF Program.
+	CombinedFSCode int `json:"combined_federal_state_code"`
+}
+
+// Type returns type of “1099-B” record
+func (r *Sub1099B) Type() string {
+	return config.Sub1099BType
+}
+
+// Parse parses the “1099-B” record from fire ascii
+func (r *Sub1099B) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub1099BLayout, record)
+}
+
+// Ascii returns fire ascii of “1099-B” record
+func (r *Sub1099B) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub1099BLayout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub1099B) Validate() error {
+	return utils.Validate(r, config.Sub1099BLayout)
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub1099B) ValidateSecondTinNotice() error {
+	if r.SecondTinNotice == config.SecondTINNotice || len(r.SecondTinNotice) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("second tin notice")
+}
+
+func (r *Sub1099B) ValidateNoncoveredSecurityIndicator() error {
+	if r.NoncoveredSecurityIndicator == config.NoncoveredSecurityIndicator || len(r.NoncoveredSecurityIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("noncovered security indicator")
+}
+
+func (r *Sub1099B) ValidateTypeOfGainLossIndicator() error {
+	if _, ok := config.GainLossIndicators[r.TypeOfGainLossIndicator]; ok || len(r.TypeOfGainLossIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("type of gain or loss indicator")
+}
+
+func (r *Sub1099B) ValidateGrossProceedsIndicator() error {
+	if _, ok := config.GrossProceedsIndicators[r.GrossProceedsIndicator]; ok || len(r.GrossProceedsIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("gross proceeds indicator")
+}
+
+func (r *Sub1099B) ValidateDateAcquired() error {
+	if len(r.DateAcquired) > 0 && len(r.DateSold) > 0 && r.DateAcquired > r.DateSold {
+		return utils.NewErrValidValue("date acquired (after date sold or disposed)")
+	}
+	return nil
+}
+
+func (r *Sub1099B) ValidateLossNotAllowedIndicator() error {
+	if r.LossNotAllowedIndicator == config.LossNotAllowedIndicator || len(r.LossNotAllowedIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("loss not allowed indicator")
+}
+
+func (r *Sub1099B) ValidateReportedToIRS() error {
+	if _, ok := config.Form8949CheckBoxes[r.ReportedToIRS]; ok || len(r.ReportedToIRS) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("applicable check box of form 8949")
+}
+
+func (r *Sub1099B) ValidateCollectiblesIndicator() error {
+	if r.CollectiblesIndicator == config.CollectiblesIndicator || len(r.CollectiblesIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("applicable check box for collectibles")
+}
+
+func (r *Sub1099B) ValidateFATCA() error {
+	if r.FATCA == config.FatcaFilingRequirementIndicator || len(r.FATCA) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("fatca filing requirement indicator")
+}
+
+func (r *Sub1099B) ValidateQOFIndicator() error {
+	if r.QOFIndicator == config.QOFIndicator || len(r.QOFIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("applicable check box for qof")
+}
+
+func (r *Sub1099B) ValidateCombinedFSCode() error {
+	return validateCombinedFSCode(r.CombinedFSCode)
+}
@@ -124,8 +124,5 @@ func (r *Sub1099INT) ValidateFATCA() error {
 }
 
 func (r *Sub1099INT) ValidateCombinedFSCode() error {
-	if _, ok := config.ParticipateStateCodes[r.CombinedFSCode]; ok {
-		return nil
-	}
-	return utils.NewErrValidValue("combined federal state code")
+	return validateCombinedFSCode(r.CombinedFSCode)
 }
@@ -0,0 +1,160 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub1099LTC struct {
+	// Enter the applicable code to indicate the type of payment.
+	// Otherwise, enter a blank.
+	// 1: Per diem
+	// 2: Reimbursed amount
+	TypeOfPayment string `json:"type_of_payment_indicator"`
+
+	// Required. Enter the Social Security Number of the insured.
+	SSNInsured string `json:"social_security_number_of_insured"`
+
+	// Required. Enter the name of the insured.
+	NameInsured string `json:"name_of_insured"`
+
+	// Required. Enter the address of the insured. The street address
+	// should include number, street, apartment or suite number (or
+	// PO Box if mail is not delivered to street address). Left justify
+	// the information and fill unused positions with blanks.
+	AddressInsured string `json:"address_of_insured"`
+
+	// Required. Enter the city, town, or post office. Left justify the
+	// information and fill unused positions with blanks. Enter APO
+	// or FPO if applicable.
+	CityInsured string `json:"city_of_insured"`
+
+	// Required. Enter U.S. Postal Service state abbreviations.
+	StateInsured string `json:"state_of_insured"`
+
+	// Required. Enter the valid nine-digit ZIP Code assigned by the
+	// U.S. Postal Service. If only the first five-digits are known, left
+	// justify the information and fill unused positions with blanks.
+	ZipCodeInsured string `json:"zip_code_of_insured"`
+
+	// Enter the applicable code to indicate the status of illness of
+	// the insured. Otherwise, enter a blank.
+	// 1: Chronically ill
+	// 2: Terminally ill
+	StatusOfIllness string `json:"status_of_illness_indicator"`
+
+	// Enter the latest date of a doctor’s certification of the status of
+	// the insured’s illness. Format the date as YYYYMMDD (for
+	// example, January 5, 2019, would be 20190105). Do not enter
+	// hyphens or slashes. Otherwise, enter blanks.
+	DateCertified string `json:"date_certified"`
+
+	// Enter “1” (one) if benefits were from a qualified long-term care
+	// insurance contract. Otherwise, enter a blank.
+	QualifiedContractIndicator string `json:"qualified_contract_indicator"`
+
+	// State income tax withheld is for the convenience of the filers.
+	// This information does not need to be reported to the IRS. If
+	// not reporting state tax withheld, this field may be used as a
+	// continuation of the Special Data Entries Field. The payment
+	// amount must be right justified and unused positions
+	// zero-filled.
+	StateIncomeTaxWithheld int `json:"state_income_tax_withheld"`
+
+	// Local income tax withheld is for the convenience of the filers.
+	// This information does not need to be reported to the IRS. If
+	// not reporting local tax withheld, this field may be used as a
+	// continuation of the Special Data Entries Field. The payment
+	// amount must be right justified and unused positions
+	// zero-filled.
+	LocalIncomeTaxWithheld int `json:"local_income_tax_withheld"`
+}
+
+// Type returns type of “1099-LTC” record
+func (r *Sub1099LTC) Type() string {
+	return config.Sub1099LtcType
+}
+
+// Parse parses the “1099-LTC” record from fire ascii
+func (r *Sub1099LTC) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub1099LTCLayout, record)
+}
+
+// Ascii returns fire ascii of “1099-LTC” record
+func (r *Sub1099LTC) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub1099LTCLayout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub1099LTC) Validate() error {
+	return utils.Validate(r, config.Sub1099LTCLayout)
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub1099LTC) ValidateTypeOfPayment() error {
+	if _, ok := config.LTCPaymentTypes[r.TypeOfPayment]; ok || len(r.TypeOfPayment) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("type of payment indicator")
+}
+
+func (r *Sub1099LTC) ValidateStateInsured() error {
+	if _, ok := config.StateAbbreviationCodes[r.StateInsured]; ok {
+		return nil
+	}
+	return utils.NewErrValidValue("state of insured")
+}
+
+func (r *Sub1099LTC) ValidateStatusOfIllness() error {
+	if _, ok := config.IllnessStatuses[r.StatusOfIllness]; ok || len(r.StatusOfIllness) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("status of illness indicator")
+}
+
+func (r *Sub1099LTC) ValidateDateCertified() error {
+	if len(r.DateCertified) > 0 && len(r.StatusOfIllness) == 0 {
+		return utils.NewErrValidValue("date certified (without status of illness)")
+	}
+	return nil
+}
+
+func (r *Sub1099LTC) ValidateQualifiedContractIndicator() error {
+	if r.QualifiedContractIndicator == config.QualifiedContractIndicator || len(r.QualifiedContractIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("qualified contract indicator")
+}
@@ -122,8 +122,5 @@ func (r *Sub1099MISC) ValidateDirectSalesIndicator() error {
 }
 
 func (r *Sub1099MISC) ValidateCombinedFSCode() error {
-	if _, ok := config.ParticipateStateCodes[r.CombinedFSCode]; ok {
-		return nil
-	}
-	return utils.NewErrValidValue("combined federal state code")
+	return validateCombinedFSCode(r.CombinedFSCode)
 }
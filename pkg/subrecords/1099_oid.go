@@ -114,8 +114,5 @@ func (r *Sub1099OID) ValidateFATCA() error {
 }
 
 func (r *Sub1099OID) ValidateCombinedFSCode() error {
-	if _, ok := config.ParticipateStateCodes[r.CombinedFSCode]; ok {
-		return nil
-	}
-	return utils.NewErrValidValue("combined federal state code")
+	return validateCombinedFSCode(r.CombinedFSCode)
 }
@@ -101,8 +101,5 @@ func (r *Sub1099PATR) ValidateVendorForeignEntityIndicator() error {
 }
 
 func (r *Sub1099PATR) ValidateCombinedFSCode() error {
-	if _, ok := config.ParticipateStateCodes[r.CombinedFSCode]; ok {
-		return nil
-	}
-	return utils.NewErrValidValue("combined federal state code")
+	return validateCombinedFSCode(r.CombinedFSCode)
 }
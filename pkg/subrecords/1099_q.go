@@ -0,0 +1,105 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub1099Q struct {
+	// Enter “1” (one) if reporting a trustee-to-trustee transfer.
+	// Otherwise, enter a blank.
+	TrusteeToTrusteeIndicator string `json:"trustee_to_trustee_transfer_indicator"`
+
+	// Enter the applicable code to indicate the type of tuition
+	// payment. Otherwise, enter a blank.
+	// 1: Private program payment
+	// 2: State program payment
+	// 3: Coverdell ESA contribution
+	TypeOfTuitionPayment string `json:"type_of_tuition_payment"`
+
+	// Enter “1” (one) if the recipient is not the designated
+	// beneficiary. Otherwise, enter a blank.
+	DesignatedBeneficiaryIndicator string `json:"designated_beneficiary"`
+
+	// This portion of the “B” Record may be used to record
+	// information for state or local government reporting or for the
+	// filer’s own purposes. Payers should contact the state or local
+	// revenue departments for filing requirements. If this field is not
+	// used, enter blanks.
+	SpecialDataEntries string `json:"special_data_entries"`
+}
+
+// Type returns type of “1099-Q” record
+func (r *Sub1099Q) Type() string {
+	return config.Sub1099QType
+}
+
+// Parse parses the “1099-Q” record from fire ascii
+func (r *Sub1099Q) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub1099QLayout, record)
+}
+
+// Ascii returns fire ascii of “1099-Q” record
+func (r *Sub1099Q) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub1099QLayout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub1099Q) Validate() error {
+	return utils.Validate(r, config.Sub1099QLayout)
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub1099Q) ValidateTrusteeToTrusteeIndicator() error {
+	if r.TrusteeToTrusteeIndicator == config.TrusteeToTrusteeIndicator || len(r.TrusteeToTrusteeIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("trustee-to-trustee transfer indicator")
+}
+
+func (r *Sub1099Q) ValidateTypeOfTuitionPayment() error {
+	if _, ok := config.TuitionPaymentTypes[r.TypeOfTuitionPayment]; ok || len(r.TypeOfTuitionPayment) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("type of tuition payment")
+}
+
+func (r *Sub1099Q) ValidateDesignatedBeneficiaryIndicator() error {
+	if r.DesignatedBeneficiaryIndicator == config.DesignatedBeneficiaryIndicator || len(r.DesignatedBeneficiaryIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("designated beneficiary indicator")
+}
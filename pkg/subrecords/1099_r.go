@@ -0,0 +1,199 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub1099R struct {
+	// Enter “2” (two) to indicate notification by the IRS twice within
+	// three calendar years that the payee provided an incorrect
+	// name and/or TIN combination. Otherwise, enter a blank.
+	SecondTinNotice string `json:"second_tin_notice"`
+
+	// Required. Enter at least one distribution code from the table
+	// in Publication 1220. More than one code may apply. If only
+	// one code is necessary, it must be entered in position 545 and
+	// position 546 will be blank. When applicable, enter a numeric
+	// code in position 545 and an alpha code in position 546.
+	// Only the valid combinations of codes can be used.
+	DistributionCode string `json:"distribution_code"`
+
+	// Enter “1” (one) only if the taxable amount of the payment
+	// entered for Payment Amount Field 1 (Gross distribution) of the
+	// “B” Record cannot be computed. Otherwise, enter a blank.
+	TaxableAmountNotDetermined string `json:"taxable_amount_not_determined_indicator"`
+
+	// Enter “1” (one) for a traditional IRA, SEP or SIMPLE
+	// distribution or Roth conversion. Otherwise, enter a blank.
+	IRASEPSIMPLEIndicator string `json:"ira_sep_simple_indicator"`
+
+	// Enter “1” (one) only if the payment shown for Distribution
+	// Amount Code 1 is a total distribution that closed out the
+	// account. Otherwise, enter a blank.
+	TotalDistributionIndicator string `json:"total_distribution_indicator"`
+
+	// Use this field when reporting a total distribution to more than
+	// one person, such as when a participant dies and a payer
+	// distributes to two or more beneficiaries. If the percentage is a
+	// whole number, enter the percentage of the total distribution. If
+	// the percentage is not a whole number, round it to the nearest
+	// whole number. Otherwise, enter blanks.
+	PercentageOfTotalDistribution string `json:"percentage_of_total_distribution"`
+
+	// Enter the first year a designated Roth contribution was made
+	// in YYYY format. If the date is unavailable, enter blanks.
+	FirstYearDesignatedRoth string `json:"first_year_of_designated_roth_contribution"`
+
+	// Enter "1" (one) if there is FATCA filing requirement.
+	// Otherwise, enter a blank.
+	FATCA string `json:"fatca_requirement_indicator"`
+
+	// Enter the date of payment for reportable death benefits under
+	// section 6050Y (Distribution Code C). Format the date as
+	// YYYYMMDD (for example, January 5, 2019, would be 20190105).
+	// Do not enter hyphens or slashes. Otherwise, enter blanks.
+	DateOfPayment string `json:"date_of_payment"`
+
+	// This portion of the “B” Record may be used to record
+	// information for state or local government reporting or for the
+	// filer’s own purposes. Payers should contact the state or local
+	// revenue departments for filing requirements. If this field is not
+	// used, enter blanks.
+	SpecialDataEntries string `json:"special_data_entries"`
+
+	// State income tax withheld is for the convenience of the filers.
+	// This information does not need to be reported to the IRS. If
+	// not reporting state tax withheld, this field may be used as a
+	// continuation of the Special Data Entries Field. The payment
+	// amount must be right justified and unused positions
+	// zero-filled.
+	StateIncomeTaxWithheld int `json:"state_income_tax_withheld"`
+
+	// Local income tax withheld is for the convenience of the filers.
+	// This information does not need to be reported to the IRS. If
+	// not reporting local tax withheld, this field may be used as a
+	// continuation of the Special Data Entries Field. The payment
+	// amount must be right justified and unused positions
+	// zero-filled.
+	LocalIncomeTaxWithheld int `json:"local_income_tax_withheld"`
+
+	// Enter the valid CF/SF code if this payee record is to be
+	// forwarded to a state agency as part of the CF/SF Program.
+	CombinedFSCode int `json:"combined_federal_state_code"`
+}
+
+// Type returns type of “1099-R” record
+func (r *Sub1099R) Type() string {
+	return config.Sub1099RType
+}
+
+// Parse parses the “1099-R” record from fire ascii
+func (r *Sub1099R) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub1099RLayout, record)
+}
+
+// Ascii returns fire ascii of “1099-R” record
+func (r *Sub1099R) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub1099RLayout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub1099R) Validate() error {
+	return utils.Validate(r, config.Sub1099RLayout)
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub1099R) ValidateSecondTinNotice() error {
+	if r.SecondTinNotice == config.SecondTINNotice || len(r.SecondTinNotice) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("second tin notice")
+}
+
+func (r *Sub1099R) ValidateDistributionCode() error {
+	codes := strings.TrimRight(r.DistributionCode, config.BlankString)
+	if len(codes) == 0 {
+		return nil
+	}
+	for _, code := range codes {
+		if _, ok := config.DistributionCodes[string(code)]; !ok {
+			return utils.NewErrValidValue("distribution code")
+		}
+	}
+	if len(codes) == 1 {
+		return nil
+	}
+	for _, code := range config.DistributionCodeCombinations[codes[:1]] {
+		if code == codes[1:] {
+			return nil
+		}
+	}
+	return utils.NewErrValidValue("distribution code (invalid combination)")
+}
+
+func (r *Sub1099R) ValidateTaxableAmountNotDetermined() error {
+	if r.TaxableAmountNotDetermined == config.TaxableAmountNotDeterminedIndicator || len(r.TaxableAmountNotDetermined) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("taxable amount not determined indicator")
+}
+
+func (r *Sub1099R) ValidateIRASEPSIMPLEIndicator() error {
+	if r.IRASEPSIMPLEIndicator == config.IRASEPSIMPLEIndicator || len(r.IRASEPSIMPLEIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("ira/sep/simple indicator")
+}
+
+func (r *Sub1099R) ValidateTotalDistributionIndicator() error {
+	if r.TotalDistributionIndicator == config.TotalDistributionIndicator || len(r.TotalDistributionIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("total distribution indicator")
+}
+
+func (r *Sub1099R) ValidateFATCA() error {
+	if r.FATCA == config.FatcaFilingRequirementIndicator || len(r.FATCA) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("fatca filing requirement indicator")
+}
+
+func (r *Sub1099R) ValidateCombinedFSCode() error {
+	return validateCombinedFSCode(r.CombinedFSCode)
+}
@@ -0,0 +1,108 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub1099S struct {
+	// Required. Enter the closing date. Format the date as
+	// YYYYMMDD (for example, January 5, 2019, would be 20190105).
+	// Do not enter hyphens or slashes.
+	DateOfClosing string `json:"date_of_closing"`
+
+	// Required. Enter the address of the property, including city,
+	// state, and ZIP Code. If the address does not sufficiently
+	// identify the property, also enter a legal description, such as
+	// section, lot, and block. For timber royalties, enter “TIMBER.”
+	// Left justify the information and fill unused positions with
+	// blanks.
+	PropertyAddress string `json:"address_or_legal_description"`
+
+	// Enter “1” (one) if the transferor received or will receive
+	// property (other than cash and consideration treated as cash in
+	// computing gross proceeds) or services as part of the
+	// consideration for the property transferred. Otherwise, enter a
+	// blank.
+	PropertyOrServicesIndicator string `json:"property_or_services_indicator"`
+
+	// Enter “1” (one) if the transferor is a foreign person
+	// (nonresident alien, foreign partnership, foreign estate, or
+	// foreign trust). Otherwise, enter a blank.
+	ForeignTransferorIndicator string `json:"foreign_transferor"`
+
+	// This portion of the “B” Record may be used to record
+	// information for state or local government reporting or for the
+	// filer’s own purposes. Payers should contact the state or local
+	// revenue departments for filing requirements. If this field is not
+	// used, enter blanks.
+	SpecialDataEntries string `json:"special_data_entries"`
+}
+
+// Type returns type of “1099-S” record
+func (r *Sub1099S) Type() string {
+	return config.Sub1099SType
+}
+
+// Parse parses the “1099-S” record from fire ascii
+func (r *Sub1099S) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub1099SLayout, record)
+}
+
+// Ascii returns fire ascii of “1099-S” record
+func (r *Sub1099S) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub1099SLayout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub1099S) Validate() error {
+	return utils.Validate(r, config.Sub1099SLayout)
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub1099S) ValidatePropertyOrServicesIndicator() error {
+	if r.PropertyOrServicesIndicator == config.PropertyOrServicesIndicator || len(r.PropertyOrServicesIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("property or services indicator")
+}
+
+func (r *Sub1099S) ValidateForeignTransferorIndicator() error {
+	if r.ForeignTransferorIndicator == config.ForeignTransferorIndicator || len(r.ForeignTransferorIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("foreign transferor indicator")
+}
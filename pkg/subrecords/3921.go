@@ -0,0 +1,123 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub3921 struct {
+	// Required. Enter the date the option was granted. Format the
+	// date as YYYYMMDD (for example, January 5, 2019, would be
+	// 20190105). Do not enter hyphens or slashes.
+	DateOptionGranted string `json:"date_option_granted"`
+
+	// Required. Enter the date the option was exercised. Format the
+	// date as YYYYMMDD (for example, January 5, 2019, would be
+	// 20190105). Do not enter hyphens or slashes.
+	DateOptionExercised string `json:"date_option_exercised"`
+
+	// Required. Enter the number of shares transferred. Report whole
+	// numbers only. Right justify the information and fill unused
+	// positions with zeros.
+	NumberShares int `json:"number_of_shares_transferred"`
+
+	// If other than the transferor, enter the name of the corporation
+	// whose stock is being transferred. Left justify the information
+	// and fill unused positions with blanks.
+	OtherName string `json:"other_name"`
+
+	// If other than the transferor, enter the mailing address of the
+	// corporation. Left justify the information and fill unused
+	// positions with blanks.
+	OtherAddress string `json:"other_address"`
+
+	// If other than the transferor, enter the city, town, or post office
+	// of the corporation. Left justify the information and fill unused
+	// positions with blanks.
+	OtherCity string `json:"other_city"`
+
+	// If other than the transferor, enter U.S. Postal Service state
+	// abbreviations of the corporation. Otherwise, enter blanks.
+	OtherState string `json:"other_state"`
+
+	// If other than the transferor, enter the valid ZIP Code of the
+	// corporation. Otherwise, enter blanks.
+	OtherZipCode string `json:"other_zip_code"`
+}
+
+// Type returns type of “3921” record
+func (r *Sub3921) Type() string {
+	return config.Sub3921Type
+}
+
+// Parse parses the “3921” record from fire ascii
+func (r *Sub3921) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub3921Layout, record)
+}
+
+// Ascii returns fire ascii of “3921” record
+func (r *Sub3921) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub3921Layout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub3921) Validate() error {
+	return utils.Validate(r, config.Sub3921Layout)
+}
+
+// ValidateAmounts checks payment amounts of the payee “B” record
+// Exercise price per share (Amount Code 3) is required
+func (r *Sub3921) ValidateAmounts(amounts map[string]int) error {
+	if amounts["3"] <= 0 {
+		return newAmountError("3", amounts["3"], utils.NewErrFieldRequired(config.AmountCodes[config.Sub3921Type]["3"]))
+	}
+	return nil
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub3921) ValidateDateOptionExercised() error {
+	if len(r.DateOptionGranted) > 0 && r.DateOptionExercised < r.DateOptionGranted {
+		return utils.NewErrValidValue("date option exercised (before date option granted)")
+	}
+	return nil
+}
+
+func (r *Sub3921) ValidateOtherState() error {
+	if _, ok := config.StateAbbreviationCodes[r.OtherState]; ok || len(r.OtherState) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("state of other than transferor")
+}
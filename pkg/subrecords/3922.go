@@ -0,0 +1,108 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub3922 struct {
+	// Required. Enter the date the option was granted. Format the
+	// date as YYYYMMDD (for example, January 5, 2019, would be
+	// 20190105). Do not enter hyphens or slashes.
+	DateOptionGranted string `json:"date_option_granted"`
+
+	// Required. Enter the date the option was exercised. Format the
+	// date as YYYYMMDD (for example, January 5, 2019, would be
+	// 20190105). Do not enter hyphens or slashes.
+	DateOptionExercised string `json:"date_option_exercised"`
+
+	// Required. Enter the number of shares transferred. Report whole
+	// numbers only. Right justify the information and fill unused
+	// positions with zeros.
+	NumberShares int `json:"number_of_shares_transferred"`
+
+	// Enter the date legal title was transferred by the transferor if
+	// the exercise price per share was not fixed or determinable on
+	// the date entered in Date Option Granted. Format the date as
+	// YYYYMMDD (for example, January 5, 2019, would be
+	// 20190105). Do not enter hyphens or slashes. Otherwise, enter
+	// blanks.
+	DateLegalTitleTransferred string `json:"date_legal_title_transferred"`
+}
+
+// Type returns type of “3922” record
+func (r *Sub3922) Type() string {
+	return config.Sub3922Type
+}
+
+// Parse parses the “3922” record from fire ascii
+func (r *Sub3922) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub3922Layout, record)
+}
+
+// Ascii returns fire ascii of “3922” record
+func (r *Sub3922) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub3922Layout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub3922) Validate() error {
+	return utils.Validate(r, config.Sub3922Layout)
+}
+
+// ValidateAmounts checks payment amounts of the payee “B” record
+// Exercise price per share (Amount Code 5) is required
+func (r *Sub3922) ValidateAmounts(amounts map[string]int) error {
+	if amounts["5"] <= 0 {
+		return newAmountError("5", amounts["5"], utils.NewErrFieldRequired(config.AmountCodes[config.Sub3922Type]["5"]))
+	}
+	return nil
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub3922) ValidateDateOptionExercised() error {
+	if len(r.DateOptionGranted) > 0 && r.DateOptionExercised < r.DateOptionGranted {
+		return utils.NewErrValidValue("date option exercised (before date option granted)")
+	}
+	return nil
+}
+
+func (r *Sub3922) ValidateDateLegalTitleTransferred() error {
+	if len(r.DateLegalTitleTransferred) > 0 && r.DateLegalTitleTransferred < r.DateOptionExercised {
+		return utils.NewErrValidValue("date legal title transferred (before date option exercised)")
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package subrecords
+
+import (
+	"bytes"
+	"reflect"
+	"unicode/utf8"
+
+	"github.com/moov-io/irs/pkg/config"
+	"github.com/moov-io/irs/pkg/utils"
+)
+
+type Sub5498 struct {
+	// Enter “1” (one) if reporting a rollover (Amount Code 2) or Fair
+	// Market Value (Amount Code 5) for an IRA. Otherwise, enter a
+	// blank.
+	IRAIndicator string `json:"ira_indicator"`
+
+	// Enter “1” (one) if reporting a rollover (Amount Code 2) or Fair
+	// Market Value (Amount Code 5) for a SEP. Otherwise, enter a
+	// blank.
+	SEPIndicator string `json:"sep_indicator"`
+
+	// Enter “1” (one) if reporting a rollover (Amount Code 2) or Fair
+	// Market Value (Amount Code 5) for a SIMPLE. Otherwise, enter
+	// a blank.
+	SIMPLEIndicator string `json:"simple_indicator"`
+
+	// Enter “1” (one) if reporting a rollover (Amount Code 2) or Fair
+	// Market Value (Amount Code 5) for a Roth IRA. Otherwise, enter
+	// a blank.
+	RothIRAIndicator string `json:"roth_ira_indicator"`
+
+	// Enter “1” (one) if reporting RMD for the following year.
+	// Otherwise, enter a blank.
+	RMDIndicator string `json:"rmd_indicator"`
+
+	// Enter the year in YYYY format for which a postponed
+	// contribution (Amount Code C) is being made. Otherwise, enter
+	// blanks.
+	YearPostponedContribution string `json:"year_of_postponed_contribution"`
+
+	// Enter the code for the type of postponed contribution (Amount
+	// Code C). Otherwise, enter blanks.
+	// FD: Federally designated disaster area
+	// PL: Public law
+	// EO: Executive order
+	PostponedContributionCode string `json:"postponed_contribution_code"`
+
+	// Enter the federal disaster designation number, public law
+	// number or executive order number for the postponed
+	// contribution. Otherwise, enter blanks.
+	PostponedContributionReason string `json:"postponed_contribution_reason"`
+
+	// Enter the code for the type of repayment (Amount Code D).
+	// Otherwise, enter blanks.
+	// QR: Qualified reservist distribution
+	// DD: Federally designated disaster distribution
+	// BA: Qualified birth or adoption distribution
+	RepaymentCode string `json:"repayment_code"`
+
+	// Enter the date by which the RMD amount must be distributed to
+	// avoid the 50% excise tax. Format the date as YYYYMMDD (for
+	// example, January 5, 2019, would be 20190105). Do not enter
+	// hyphens or slashes. Otherwise, enter blanks.
+	RMDDate string `json:"rmd_date"`
+
+	// This portion of the “B” Record may be used to record
+	// information for state or local government reporting or for the
+	// filer’s own purposes. Payers should contact the state or local
+	// revenue departments for filing requirements. If this field is not
+	// used, enter blanks.
+	SpecialDataEntries string `json:"special_data_entries"`
+}
+
+// Type returns type of “5498” record
+func (r *Sub5498) Type() string {
+	return config.Sub5498Type
+}
+
+// Parse parses the “5498” record from fire ascii
+func (r *Sub5498) Parse(buf []byte) error {
+	record := string(buf)
+	if utf8.RuneCountInString(record) != config.SubRecordLength {
+		return utils.ErrRecordLength
+	}
+
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return utils.ErrValidField
+	}
+
+	return utils.ParseValue(fields, config.Sub5498Layout, record)
+}
+
+// Ascii returns fire ascii of “5498” record
+func (r *Sub5498) Ascii() []byte {
+	var buf bytes.Buffer
+	records := config.ToSpecifications(config.Sub5498Layout)
+	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return nil
+	}
+
+	buf.Grow(config.SubRecordLength)
+	for _, spec := range records {
+		value := utils.ToString(spec.Field, fields.FieldByName(spec.Name))
+		buf.WriteString(value)
+	}
+
+	return buf.Bytes()
+}
+
+// Validate performs some checks on the record and returns an error if not Validated
+func (r *Sub5498) Validate() error {
+	return utils.Validate(r, config.Sub5498Layout)
+}
+
+// ValidateAmounts checks payment amounts of the payee “B” record
+// Postponed contribution (Amount Code C) requires the year and code of the postponed contribution,
+// and repayments (Amount Code D) require the repayment code
+func (r *Sub5498) ValidateAmounts(amounts map[string]int) error {
+	report := &utils.ValidationReport{}
+	if amounts["C"] != 0 {
+		if len(r.YearPostponedContribution) == 0 {
+			report.Add(nil, newAmountError("C", amounts["C"], utils.NewErrFieldRequired("YearPostponedContribution")))
+		}
+		if len(r.PostponedContributionCode) == 0 {
+			report.Add(nil, newAmountError("C", amounts["C"], utils.NewErrFieldRequired("PostponedContributionCode")))
+		}
+	}
+	if amounts["D"] != 0 && len(r.RepaymentCode) == 0 {
+		report.Add(nil, newAmountError("D", amounts["D"], utils.NewErrFieldRequired("RepaymentCode")))
+	}
+	return report.Err()
+}
+
+// customized field validation functions
+// function name should be "Validate" + field name
+
+func (r *Sub5498) ValidateIRAIndicator() error {
+	if r.IRAIndicator == config.AccountTypeIndicator || len(r.IRAIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("ira indicator")
+}
+
+func (r *Sub5498) ValidateSEPIndicator() error {
+	if r.SEPIndicator == config.AccountTypeIndicator || len(r.SEPIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("sep indicator")
+}
+
+func (r *Sub5498) ValidateSIMPLEIndicator() error {
+	if r.SIMPLEIndicator == config.AccountTypeIndicator || len(r.SIMPLEIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("simple indicator")
+}
+
+func (r *Sub5498) ValidateRothIRAIndicator() error {
+	if r.RothIRAIndicator == config.AccountTypeIndicator || len(r.RothIRAIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("roth ira indicator")
+}
+
+func (r *Sub5498) ValidateRMDIndicator() error {
+	if r.RMDIndicator == config.RMDIndicator || len(r.RMDIndicator) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("rmd indicator")
+}
+
+func (r *Sub5498) ValidatePostponedContributionCode() error {
+	if _, ok := config.PostponedContributionCodes[r.PostponedContributionCode]; ok || len(r.PostponedContributionCode) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("postponed contribution code")
+}
+
+func (r *Sub5498) ValidateRepaymentCode() error {
+	if _, ok := config.RepaymentCodes[r.RepaymentCode]; ok || len(r.RepaymentCode) == 0 {
+		return nil
+	}
+	return utils.NewErrValidValue("repayment code")
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"errors"
 	"fmt"
+	"strings"
 )
 
 var (
@@ -28,14 +29,151 @@ var (
 	ErrInvalidAscii = errors.New("is invalid ascii")
 	// ErrInvalidFile is given when is invalid file
 	ErrInvalidFile = errors.New("is invalid file")
+	// ErrNegativeAmount is given when a payment amount is negative for type of return that doesn't report a loss
+	ErrNegativeAmount = errors.New("is a negative amount")
+	// ErrFSFilingProgram is given when payer has state totals “K” records without participating in the CF/SF Program
+	ErrFSFilingProgram = errors.New("should be approved for the Combined Federal/State Filing Program to report state totals")
+	// ErrPriorYearData is given when prior year data indicator is blank for payment year before the current tax year
+	ErrPriorYearData = errors.New("should be “P” when reporting prior year data")
+	// ErrCurrentYearData is given when prior year data indicator is “P” for payment year of the current tax year
+	ErrCurrentYearData = errors.New("should be blank when reporting current year data")
+	// ErrNonemployeeCompensation is given when 1099-MISC reports nonemployee compensation of payment year that reports it by 1099-NEC
+	ErrNonemployeeCompensation = errors.New("should not include amount code 7 of 1099-MISC, nonemployee compensation is reported by 1099-NEC since payment year 2020")
+	// ErrMixedCorrectedReturns is given when payees of a payer “A” record have different corrected return indicators
+	ErrMixedCorrectedReturns = errors.New("should be reported under a separate “A” record from payees with a different corrected return indicator")
 )
 
+// Stable codes of errors, used by validation reports
+const (
+	CodeNonAlphanumeric    = "non_alphanumeric"
+	CodeNumeric            = "non_numeric"
+	CodePhoneNumber        = "invalid_phone_number"
+	CodeValidDate          = "invalid_date"
+	CodeRecordLength       = "invalid_length"
+	CodeValidField         = "invalid_field"
+	CodeShortRecord        = "short_record"
+	CodeEmail              = "invalid_email"
+	CodePayeeExtBlock      = "missing_extension_block"
+	CodeInvalidAscii       = "invalid_ascii"
+	CodeInvalidFile        = "invalid_file"
+	CodeNegativeAmount     = "negative_amount"
+	CodeFSFilingProgram    = "missing_cfsf_program"
+	CodeMissingState       = "missing_state_record"
+	CodePriorYearData      = "invalid_prior_year_data"
+	CodeMismatchedField    = "mismatched_field"
+	CodeUnreportedAmount   = "unreported_amount_code"
+	CodeMixedCorrections   = "mixed_corrected_returns"
+	CodeNonemployeeComp    = "nonemployee_compensation"
+	CodeUnknownPayee       = "unknown_payee"
+	CodeUnknownField       = "unknown_field"
+	CodeValidValue         = "invalid_value"
+	CodeFieldRequired      = "required_field"
+	CodeUnexpectedTotal    = "unexpected_total"
+	CodeAmountExceeded     = "amount_exceeded"
+	CodeDuplicatedSequence = "duplicated_sequence_number"
+	CodeUnexpectedSequence = "unexpected_sequence_number"
+	CodeUnknown            = "unknown"
+)
+
+var errorCodes = map[error]string{
+	ErrNonAlphanumeric:         CodeNonAlphanumeric,
+	ErrNumeric:                 CodeNumeric,
+	ErrPhoneNumber:             CodePhoneNumber,
+	ErrValidDate:               CodeValidDate,
+	ErrRecordLength:            CodeRecordLength,
+	ErrValidField:              CodeValidField,
+	ErrShortRecord:             CodeShortRecord,
+	ErrEmail:                   CodeEmail,
+	ErrPayeeExtBlock:           CodePayeeExtBlock,
+	ErrInvalidAscii:            CodeInvalidAscii,
+	ErrInvalidFile:             CodeInvalidFile,
+	ErrNegativeAmount:          CodeNegativeAmount,
+	ErrFSFilingProgram:         CodeFSFilingProgram,
+	ErrPriorYearData:           CodePriorYearData,
+	ErrCurrentYearData:         CodePriorYearData,
+	ErrMixedCorrectedReturns:   CodeMixedCorrections,
+	ErrNonemployeeCompensation: CodeNonemployeeComp,
+}
+
+// codedError is an error that has a stable code
+type codedError struct {
+	code    string
+	message string
+}
+
+func (e *codedError) Error() string {
+	return e.message
+}
+
+// ErrorCode returns the stable code of an error
+func ErrorCode(err error) string {
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+	var field *FieldError
+	if errors.As(err, &field) {
+		return field.Code
+	}
+	for target, code := range errorCodes {
+		if errors.Is(err, target) {
+			return code
+		}
+	}
+	return CodeUnknown
+}
+
 // NewErrValidValue returns a error that has invalid value
 func NewErrValidValue(field string) error {
-	return fmt.Errorf("is an invalid value of %s", field)
+	return &codedError{CodeValidValue, fmt.Sprintf("is an invalid value of %s", field)}
 }
 
 // NewErrFieldRequired returns a error that has empty required field
 func NewErrFieldRequired(field string) error {
-	return fmt.Errorf("is required field (%s)", field)
+	return &codedError{CodeFieldRequired, fmt.Sprintf("is required field (%s)", field)}
+}
+
+// NewErrUnexpectedTotal returns a error that has total different from the accumulated value
+func NewErrUnexpectedTotal(field string, expected, actual int) error {
+	return &codedError{CodeUnexpectedTotal, fmt.Sprintf("has unexpected total of %s (expected %d, got %d)", field, expected, actual)}
+}
+
+// NewErrSequenceNumber returns a error that has unexpected record sequence number
+func NewErrSequenceNumber(recordType string, position, expected, actual int) error {
+	return &codedError{CodeUnexpectedSequence, fmt.Sprintf("has unexpected sequence number %d, expected %d (%s record at position %d)", actual, expected, recordType, position)}
+}
+
+// NewErrDuplicatedSequenceNumber returns a error that has record sequence number of a previous record
+func NewErrDuplicatedSequenceNumber(recordType string, position, actual int) error {
+	return &codedError{CodeDuplicatedSequence, fmt.Sprintf("has duplicated sequence number %d (%s record at position %d)", actual, recordType, position)}
+}
+
+// NewErrAmountExceeded returns a error that has amount greater than the limit
+func NewErrAmountExceeded(limit string) error {
+	return &codedError{CodeAmountExceeded, fmt.Sprintf("is greater than %s", strings.ToLower(limit))}
+}
+
+// NewErrMissingStateRecord returns a error that has no state totals “K” record for the CF/SF code of payees
+func NewErrMissingStateRecord(code int) error {
+	return &codedError{CodeMissingState, fmt.Sprintf("should exist state totals “K” record for combined federal state code %d", code)}
+}
+
+// NewErrMismatchedField returns a error that has value different from the field of the related record
+func NewErrMismatchedField(field, recordType string, value interface{}) error {
+	return &codedError{CodeMismatchedField, fmt.Sprintf("is different from %s %v of “%s” record", field, value, recordType)}
+}
+
+// NewErrUnreportedAmount returns a error that has non-zero payment amount of amount code that isn't reported by payer
+func NewErrUnreportedAmount(code string) error {
+	return &codedError{CodeUnreportedAmount, fmt.Sprintf("should be zero for amount code %s that isn’t in amount codes of “A” record", code)}
+}
+
+// NewErrUnknownPayee returns a error that has no payee “B” record with the sequence number in the filed file
+func NewErrUnknownPayee(sequenceNumber int) error {
+	return &codedError{CodeUnknownPayee, fmt.Sprintf("has no payee “B” record with sequence number %d in the filed file", sequenceNumber)}
+}
+
+// NewErrUnknownField returns a error that has no field of the name in records of the type of return
+func NewErrUnknownField(field, typeOfReturn string) error {
+	return &codedError{CodeUnknownField, fmt.Sprintf("is an unknown field %s of records of type of return %s", field, typeOfReturn)}
 }
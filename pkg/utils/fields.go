@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 	"unicode"
 
 	"github.com/moov-io/irs/pkg/config"
@@ -14,6 +15,7 @@ import (
 var (
 	upperAlphanumericRegex = regexp.MustCompile(`[^ A-Z0-9!"#$%&'()*+,-.\\/:;<>=?@\[\]^_{}|~]+`)
 	numericRegex           = regexp.MustCompile(`^[0-9]+$`)
+	signedNumericRegex     = regexp.MustCompile(`^[+-]?[0-9]+$`)
 	yearRegex              = regexp.MustCompile(`((19|20)\d\d)`)
 	emailRegex             = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
 	minPhoneNumberLength   = 10
@@ -40,11 +42,11 @@ func ParseValue(fields reflect.Value, spec map[string]config.SpecField, record s
 
 		data := record[spec.Start : spec.Start+spec.Length]
 		if err := isValidType(fieldName, spec, data); err != nil {
-			return err
+			return NewFieldError(fieldName, spec, data, err)
 		}
 
 		if err := parseValue(spec, field, data); err != nil {
-			return err
+			return NewFieldError(fieldName, spec, data, err)
 		}
 	}
 	return nil
@@ -61,12 +63,19 @@ func ToString(elm config.SpecField, data reflect.Value) string {
 	}
 
 	sizeStr := strconv.Itoa(elm.Length)
+	if elm.Type == config.Numeric && data.Kind() == reflect.Int {
+		// numeric amounts that are blank filled when there is no amount
+		if data.Int() == 0 {
+			return fillString(elm)
+		}
+		return fmt.Sprintf("%0"+sizeStr+"d", data)
+	}
 	switch elm.Type {
-	case config.Alphanumeric, config.Email, config.Numeric, config.TelephoneNumber:
+	case config.Alphanumeric, config.Email, config.Numeric, config.TelephoneNumber, config.Date:
 		return fmt.Sprintf("%-"+sizeStr+"s", data)
 	case config.AlphanumericRightAlign:
 		return fmt.Sprintf("%"+sizeStr+"s", data)
-	case config.ZeroNumeric:
+	case config.ZeroNumeric, config.ZeroNumericSigned:
 		return fmt.Sprintf("%0"+sizeStr+"d", data)
 	case config.DateYear:
 		return fmt.Sprintf("%-"+sizeStr+"d", data)
@@ -75,20 +84,34 @@ func ToString(elm config.SpecField, data reflect.Value) string {
 	return fillString(elm)
 }
 
-// to validate fields of record
+// to validate fields of record, collecting all problems into a validation report
 func Validate(r interface{}, spec map[string]config.SpecField) error {
+	report := &ValidationReport{}
+	record, _ := r.(RecordInfo)
+
 	fields := reflect.ValueOf(r).Elem()
+	if !fields.IsValid() {
+		return ErrValidField
+	}
+
 	for i := 0; i < fields.NumField(); i++ {
 		fieldName := fields.Type().Field(i).Name
-		if !fields.IsValid() {
-			return ErrValidField
+		fieldSpec, hasSpec := spec[fieldName]
+
+		if hasSpec && fieldSpec.Required == config.Required {
+			fieldValue := fields.FieldByName(fieldName)
+			if fieldValue.IsZero() {
+				report.Add(record, NewFieldError(fieldName, fieldSpec, fieldValue.Interface(), NewErrFieldRequired(fieldName)))
+				continue
+			}
 		}
 
-		if spec, ok := spec[fieldName]; ok {
-			if spec.Required == config.Required {
-				fieldValue := fields.FieldByName(fieldName)
-				if fieldValue.IsZero() {
-					return NewErrFieldRequired(fieldName)
+		if hasSpec && fieldSpec.Type == config.Date {
+			fieldValue := fields.FieldByName(fieldName)
+			if !fieldValue.IsZero() {
+				if err := isDate(fieldValue.String()); err != nil {
+					report.Add(record, NewFieldError(fieldName, fieldSpec, fieldValue.Interface(), err))
+					continue
 				}
 			}
 		}
@@ -97,18 +120,19 @@ func Validate(r interface{}, spec map[string]config.SpecField) error {
 		method := reflect.ValueOf(r).MethodByName(funcName)
 		if method.IsValid() {
 			response := method.Call(nil)
-			if len(response) == 0 {
+			if len(response) == 0 || response[0].IsNil() {
 				continue
 			}
 
-			err := method.Call(nil)[0]
-			if !err.IsNil() {
-				return err.Interface().(error)
+			err := response[0].Interface().(error)
+			if hasSpec {
+				err = NewFieldError(fieldName, fieldSpec, fields.FieldByName(fieldName).Interface(), err)
 			}
+			report.Add(record, err)
 		}
 	}
 
-	return nil
+	return report.Err()
 }
 
 // to copy fields between struct instances
@@ -153,8 +177,12 @@ func isValidType(fieldName string, elm config.SpecField, data string) error {
 		return isNumeric(data)
 	case config.Email:
 		return isEmail(data)
+	case config.ZeroNumericSigned:
+		return isSignedNumeric(data)
 	case config.DateYear:
 		return isDateYear(data)
+	case config.Date:
+		return isDate(data)
 	}
 
 	return NewErrValidValue(fieldName)
@@ -175,6 +203,13 @@ func isNumeric(data string) error {
 	return nil
 }
 
+func isSignedNumeric(data string) error {
+	if !signedNumericRegex.MatchString(data) {
+		return ErrNumeric
+	}
+	return nil
+}
+
 func isAlphanumeric(data string) error {
 	if upperAlphanumericRegex.MatchString(data) {
 		return ErrNonAlphanumeric
@@ -189,6 +224,14 @@ func isDateYear(data string) error {
 	return nil
 }
 
+func isDate(data string) error {
+	data = strings.TrimRight(data, config.BlankString)
+	if _, err := time.Parse(config.DateFormat, data); err != nil {
+		return ErrValidDate
+	}
+	return nil
+}
+
 func isEmail(data string) error {
 	data = strings.TrimRight(data, config.BlankString)
 	if !emailRegex.MatchString(data) {
@@ -198,19 +241,32 @@ func isEmail(data string) error {
 }
 
 func fillString(elm config.SpecField) string {
-	if elm.Type == config.ZeroNumeric {
+	if elm.Type == config.ZeroNumeric || elm.Type == config.ZeroNumericSigned {
 		return strings.Repeat(config.ZeroString, elm.Length)
 	}
 	return strings.Repeat(config.BlankString, elm.Length)
 }
 
 func parseValue(elm config.SpecField, field reflect.Value, data string) error {
+	if elm.Type == config.Numeric && field.Kind() == reflect.Int {
+		data = strings.TrimSpace(data)
+		if len(data) == 0 {
+			field.SetInt(0)
+			return nil
+		}
+		value, err := strconv.ParseInt(data, 10, 64)
+		if err != nil {
+			return err
+		}
+		field.SetInt(value)
+		return nil
+	}
 	switch elm.Type {
-	case config.Alphanumeric, config.AlphanumericRightAlign, config.Email, config.Numeric, config.TelephoneNumber:
+	case config.Alphanumeric, config.AlphanumericRightAlign, config.Email, config.Numeric, config.TelephoneNumber, config.Date:
 		data = strings.TrimRight(data, config.BlankString)
 		field.SetString(data)
 		return nil
-	case config.ZeroNumeric, config.DateYear:
+	case config.ZeroNumeric, config.ZeroNumericSigned, config.DateYear:
 		value, err := strconv.ParseInt(data, 10, 64)
 		if err != nil {
 			return err
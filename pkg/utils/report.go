@@ -0,0 +1,142 @@
+// Copyright 2020 The Moov Authors
+// Use of this source code is governed by an Apache License
+// license that can be found in the LICENSE file.
+
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/moov-io/irs/pkg/config"
+)
+
+// RecordInfo identifies the record that a validation problem belongs to
+type RecordInfo interface {
+	Type() string
+	SequenceNumber() int
+}
+
+// FieldError describes a validation problem of a record field
+type FieldError struct {
+	// Type of the record, e.g. “B”
+	RecordType string `json:"record_type,omitempty"`
+	// Record sequence number of the record
+	SequenceNumber int `json:"record_sequence_number,omitempty"`
+	// 1-based row of an imported file, such as a csv file, that the record was read from
+	Row int `json:"row,omitempty"`
+	// Name of the field
+	FieldName string `json:"field_name,omitempty"`
+	// 1-based column range of the field in the record
+	StartColumn int `json:"start_column,omitempty"`
+	EndColumn   int `json:"end_column,omitempty"`
+	// Offending value of the field
+	Value string `json:"value,omitempty"`
+	// Stable code of the problem
+	Code string `json:"code"`
+	// Message of the problem
+	Message string `json:"message"`
+
+	Err error `json:"-"`
+}
+
+// NewFieldError returns a field error with columns of the field specification
+func NewFieldError(name string, spec config.SpecField, value interface{}, err error) *FieldError {
+	return &FieldError{
+		FieldName:   name,
+		StartColumn: spec.Start + 1,
+		EndColumn:   spec.Start + spec.Length,
+		Value:       fmt.Sprint(value),
+		Code:        ErrorCode(err),
+		Message:     err.Error(),
+		Err:         err,
+	}
+}
+
+// Error returns the description of the problem
+func (e *FieldError) Error() string {
+	var location []string
+	if e.Row > 0 {
+		location = append(location, fmt.Sprintf("row %d", e.Row))
+	}
+	if len(e.RecordType) > 0 {
+		location = append(location, fmt.Sprintf("%s record #%d", e.RecordType, e.SequenceNumber))
+	}
+	if len(e.FieldName) > 0 {
+		location = append(location, fmt.Sprintf("%s (columns %d-%d)", e.FieldName, e.StartColumn, e.EndColumn))
+	}
+	if len(location) == 0 {
+		return e.Message
+	}
+	return strings.Join(location, " ") + ": " + e.Message
+}
+
+// Unwrap returns the underlying error
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// ValidationReport collects all validation problems of a file or record
+type ValidationReport struct {
+	Errors []*FieldError `json:"errors"`
+}
+
+// Error returns descriptions of all problems
+func (r *ValidationReport) Error() string {
+	messages := make([]string, len(r.Errors))
+	for i, err := range r.Errors {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Add appends problems of err, filling the record information with record
+func (r *ValidationReport) Add(record RecordInfo, err error) {
+	r.AddExtension(record, err, 0)
+}
+
+// AddExtension appends problems of err that are found in an extension block located at offset of record
+func (r *ValidationReport) AddExtension(record RecordInfo, err error, offset int) {
+	switch e := err.(type) {
+	case nil:
+		return
+	case *ValidationReport:
+		for _, fieldErr := range e.Errors {
+			r.addFieldError(record, fieldErr, offset)
+		}
+	case *FieldError:
+		r.addFieldError(record, e, offset)
+	default:
+		r.addFieldError(record, &FieldError{Code: ErrorCode(err), Message: err.Error(), Err: err}, offset)
+	}
+}
+
+// AddRow appends problems of err that are found in the row of an imported file
+func (r *ValidationReport) AddRow(row int, err error) {
+	rowReport := &ValidationReport{}
+	rowReport.Add(nil, err)
+	for _, fieldErr := range rowReport.Errors {
+		fieldErr.Row = row
+	}
+	r.Errors = append(r.Errors, rowReport.Errors...)
+}
+
+// Err returns the report as error, or nil if there are no problems
+func (r *ValidationReport) Err() error {
+	if r == nil || len(r.Errors) == 0 {
+		return nil
+	}
+	return r
+}
+
+func (r *ValidationReport) addFieldError(record RecordInfo, err *FieldError, offset int) {
+	if len(err.RecordType) == 0 && record != nil {
+		err.RecordType = record.Type()
+		err.SequenceNumber = record.SequenceNumber()
+	}
+	if offset > 0 && err.StartColumn > 0 {
+		err.StartColumn += offset
+		err.EndColumn += offset
+	}
+	r.Errors = append(r.Errors, err)
+}